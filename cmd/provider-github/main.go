@@ -1,12 +1,17 @@
 package main
 
 import (
+	"os"
+
 	githubProvider "github.com/go-semantic-release/provider-github/pkg/provider"
 	"github.com/go-semantic-release/semantic-release/v2/pkg/plugin"
 	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "rollback" {
+		os.Exit(rollback(os.Args[2:]))
+	}
 	plugin.Serve(&plugin.ServeOpts{
 		Provider: func() provider.Provider {
 			return &githubProvider.GitHubRepository{}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	githubProvider "github.com/go-semantic-release/provider-github/pkg/provider"
+)
+
+type providerOpts map[string]string
+
+func (o providerOpts) String() string {
+	return fmt.Sprint(map[string]string(o))
+}
+
+func (o providerOpts) Set(value string) error {
+	key, val, found := strings.Cut(value, "=")
+	if !found {
+		return fmt.Errorf("invalid provider option %s, expected key=value", value)
+	}
+	o[key] = val
+	return nil
+}
+
+func confirm(in io.Reader, out io.Writer, question string) bool {
+	fmt.Fprintf(out, "%s [y/N] ", question)
+	answer, _ := bufio.NewReader(in).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// rollback implements the rollback command, e.g. provider-github rollback -provider-opt slug=owner/repo 1.2.3
+func rollback(args []string) int {
+	opts := providerOpts{}
+	fs := flag.NewFlagSet("rollback", flag.ContinueOnError)
+	fs.Var(opts, "provider-opt", "provider option key=value, can be repeated")
+	unpublish := fs.Bool("unpublish", false, "convert the release back to a draft instead of deleting the release and its tag")
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: provider-github rollback [flags] <version>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+	version := fs.Arg(0)
+
+	repo := &githubProvider.GitHubRepository{}
+	if err := repo.Init(opts); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	question := fmt.Sprintf("Delete the release %s and its tag?", version)
+	if *unpublish {
+		question = fmt.Sprintf("Convert the release %s back to a draft?", version)
+	}
+	if !*yes && !confirm(os.Stdin, os.Stderr, question) {
+		fmt.Fprintln(os.Stderr, "aborted")
+		return 1
+	}
+	if err := repo.RollbackRelease(version, *unpublish); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
@@ -4,13 +4,17 @@ go 1.23
 
 require (
 	github.com/Masterminds/semver/v3 v3.3.0
+	github.com/ProtonMail/go-crypto v1.1.6
 	github.com/go-semantic-release/semantic-release/v2 v2.31.0
 	github.com/google/go-github/v66 v66.0.0
 	github.com/stretchr/testify v1.9.0
+	golang.org/x/crypto v0.28.0
 	golang.org/x/oauth2 v0.23.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
+	github.com/cloudflare/circl v1.3.7 // indirect
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
 	github.com/fatih/color v1.17.0 // indirect
 	github.com/fsnotify/fsnotify v1.7.0 // indirect
@@ -47,5 +51,4 @@ require (
 	google.golang.org/grpc v1.67.1 // indirect
 	google.golang.org/protobuf v1.35.1 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
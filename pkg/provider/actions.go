@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// actionsDefaults returns the commit and branch of the workflow run when running in GitHub Actions, they are used if
+// the host does not supply the release SHA or branch. The branch is empty for runs triggered by tags.
+func actionsDefaults() (string, string) {
+	if os.Getenv("GITHUB_ACTIONS") != "true" {
+		return "", ""
+	}
+	branch := ""
+	if os.Getenv("GITHUB_REF_TYPE") == "branch" {
+		branch = os.Getenv("GITHUB_REF_NAME")
+	}
+	return os.Getenv("GITHUB_SHA"), branch
+}
+
+// appendToEnvFile appends content to the file referenced by the environment variable, nothing is written if the
+// variable is not set, e.g. outside of GitHub Actions.
+func appendToEnvFile(name, content string) error {
+	path := os.Getenv(name)
+	if path == "" {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+func releaseSummary(version, tag, body string, release *github.RepositoryRelease) string {
+	var summary strings.Builder
+	fmt.Fprintf(&summary, "## :rocket: Released %s\n\n", version)
+	status := ""
+	if release.GetDraft() {
+		status = " (draft)"
+	}
+	if url := release.GetHTMLURL(); url != "" {
+		fmt.Fprintf(&summary, "[%s](%s)%s\n\n", tag, url, status)
+	} else {
+		fmt.Fprintf(&summary, "%s%s\n\n", tag, status)
+	}
+	if body = strings.TrimSpace(body); body != "" {
+		summary.WriteString(body + "\n")
+	}
+	return summary.String()
+}
+
+// writeActionsOutputs sets the version, tag, release_id and release_url step outputs and renders the release into the
+// job summary when running in GitHub Actions.
+func writeActionsOutputs(version, tag, body string, release *github.RepositoryRelease) error {
+	outputs := fmt.Sprintf("version=%s\ntag=%s\nrelease_id=%d\nrelease_url=%s\n", version, tag, release.GetID(), release.GetHTMLURL())
+	if err := appendToEnvFile("GITHUB_OUTPUT", outputs); err != nil {
+		return err
+	}
+	return appendToEnvFile("GITHUB_STEP_SUMMARY", releaseSummary(version, tag, body, release))
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubActionsOutputs(t *testing.T) {
+	dir := t.TempDir()
+	outputFile := filepath.Join(dir, "output")
+	summaryFile := filepath.Join(dir, "summary")
+	require.NoError(t, os.WriteFile(outputFile, []byte("previous=true\n"), 0o644))
+	t.Setenv("GITHUB_OUTPUT", outputFile)
+	t.Setenv("GITHUB_STEP_SUMMARY", summaryFile)
+
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+	releaseURL := "https://github.com/owner/test-repo/releases/tag/v2.0.0"
+	rec.handle("POST /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.RepositoryRelease{ID: github.Int64(1), HTMLURL: &releaseURL})
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "* feat: new feature"})
+	require.NoError(t, err)
+	output, err := os.ReadFile(outputFile)
+	require.NoError(t, err)
+	require.Equal(t, "previous=true\nversion=2.0.0\ntag=v2.0.0\nrelease_id=1\nrelease_url="+releaseURL+"\n", string(output))
+	summary, err := os.ReadFile(summaryFile)
+	require.NoError(t, err)
+	require.Equal(t, "## :rocket: Released 2.0.0\n\n[v2.0.0]("+releaseURL+")\n\n* feat: new feature\n", string(summary))
+}
+
+func TestReleaseSummary(t *testing.T) {
+	draft := &github.RepositoryRelease{Draft: github.Bool(true)}
+	require.Equal(t, "## :rocket: Released 1.0.0\n\nv1.0.0 (draft)\n\n", releaseSummary("1.0.0", "v1.0.0", " ", draft))
+}
+
+func TestActionsDefaults(t *testing.T) {
+	t.Setenv("GITHUB_ACTIONS", "true")
+	t.Setenv("GITHUB_SHA", testSHA)
+	t.Setenv("GITHUB_REF_NAME", "main")
+	t.Setenv("GITHUB_REF_TYPE", "branch")
+	sha, branch := actionsDefaults()
+	require.Equal(t, testSHA, sha)
+	require.Equal(t, "main", branch)
+
+	t.Setenv("GITHUB_REF_TYPE", "tag")
+	sha, branch = actionsDefaults()
+	require.Equal(t, testSHA, sha)
+	require.Empty(t, branch)
+
+	t.Setenv("GITHUB_ACTIONS", "")
+	sha, branch = actionsDefaults()
+	require.Empty(t, sha)
+	require.Empty(t, branch)
+}
+
+func TestGithubCreateReleaseActionsDefaults(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+	repo.defaultSHA, repo.defaultBranch = testSHA, "main"
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0"})
+	require.NoError(t, err)
+	require.Equal(t, "main", rec.lastRelease().GetTargetCommitish())
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+const defaultAnnouncementTitle = "Release {{.Tag}}"
+
+const discussionCategoriesQuery = `query($owner: String!, $name: String!) {
+  repository(owner: $owner, name: $name) {
+    id
+    discussionCategories(first: 100) { nodes { id name slug } }
+  }
+}`
+
+const createDiscussionMutation = `mutation($repositoryId: ID!, $categoryId: ID!, $title: String!, $body: String!) {
+  createDiscussion(input: {repositoryId: $repositoryId, categoryId: $categoryId, title: $title, body: $body}) {
+    discussion { url }
+  }
+}`
+
+type discussionCategoriesResult struct {
+	Repository *struct {
+		ID                   string
+		DiscussionCategories struct {
+			Nodes []struct {
+				ID   string
+				Name string
+				Slug string
+			}
+		}
+	}
+}
+
+type createDiscussionResult struct {
+	CreateDiscussion struct {
+		Discussion struct {
+			URL string
+		}
+	}
+}
+
+// parseAnnouncementRepo returns the owner and name of the repository of the announcements, defaulting to the
+// released repository.
+func (repo *GitHubRepository) parseAnnouncementRepo(slug string) (string, string, error) {
+	if slug == "" {
+		return repo.owner, repo.repo, nil
+	}
+	owner, name, ok := strings.Cut(slug, "/")
+	if !ok || owner == "" || name == "" {
+		return "", "", fmt.Errorf("invalid github_announcement_repo: %s", slug)
+	}
+	return owner, name, nil
+}
+
+func parseAnnouncementTitle(value string) (*template.Template, error) {
+	if value == "" {
+		value = defaultAnnouncementTitle
+	}
+	tmpl, err := template.New("github_announcement_title").Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse github_announcement_title: %w", err)
+	}
+	return tmpl, nil
+}
+
+// postAnnouncement creates a discussion with the release body and a link to the release in the configured category,
+// which is matched by name or slug.
+func (repo *GitHubRepository) postAnnouncement(data releaseNameData, body, releaseURL string) error {
+	var categories discussionCategoriesResult
+	err := repo.graphQL(discussionCategoriesQuery, map[string]interface{}{"owner": repo.announcementOwner, "name": repo.announcementRepo}, &categories)
+	if err != nil {
+		return fmt.Errorf("failed to list discussion categories: %w", err)
+	}
+	if categories.Repository == nil {
+		return fmt.Errorf("repository %s/%s not found", repo.announcementOwner, repo.announcementRepo)
+	}
+	categoryID := ""
+	for _, category := range categories.Repository.DiscussionCategories.Nodes {
+		if strings.EqualFold(category.Name, repo.announcementCategory) || category.Slug == repo.announcementCategory {
+			categoryID = category.ID
+			break
+		}
+	}
+	if categoryID == "" {
+		return fmt.Errorf("discussion category %s not found in %s/%s", repo.announcementCategory, repo.announcementOwner, repo.announcementRepo)
+	}
+	var title strings.Builder
+	if err := repo.announcementTitle.Execute(&title, data); err != nil {
+		return fmt.Errorf("failed to render github_announcement_title: %w", err)
+	}
+	if releaseURL != "" {
+		body = appendSection(body, fmt.Sprintf("**Release**: %s", releaseURL))
+	}
+	err = repo.graphQL(createDiscussionMutation, map[string]interface{}{
+		"repositoryId": categories.Repository.ID,
+		"categoryId":   categoryID,
+		"title":        strings.TrimSpace(title.String()),
+		"body":         body,
+	}, &createDiscussionResult{})
+	if err != nil {
+		return fmt.Errorf("failed to create announcement: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubAnnouncement(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_announcement_category": "announcements",
+		"github_announcement_repo":     "my-org/news",
+	})
+	defer ts.Close()
+	releaseURL := "https://github.com/owner/test-repo/releases/tag/v2.0.0"
+	rec.handle("POST /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.RepositoryRelease{ID: github.Int64(1), HTMLURL: &releaseURL})
+	})
+	var mutation graphQLRequest
+	rec.handle("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+		var request graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		if strings.HasPrefix(request.Query, "query") {
+			require.Equal(t, map[string]interface{}{"owner": "my-org", "name": "news"}, request.Variables)
+			fmt.Fprint(w, `{"data":{"repository":{"id":"R_1","discussionCategories":{"nodes":[
+				{"id":"C_1","name":"General","slug":"general"},
+				{"id":"C_2","name":"Announcements","slug":"announcements"}
+			]}}}}`)
+			return
+		}
+		mutation = request
+		fmt.Fprint(w, `{"data":{"createDiscussion":{"discussion":{"url":"https://github.com/my-org/news/discussions/1"}}}}`)
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"repositoryId": "R_1",
+		"categoryId":   "C_2",
+		"title":        "Release v2.0.0",
+		"body":         "changelog\n\n**Release**: " + releaseURL,
+	}, mutation.Variables)
+}
+
+func TestGithubAnnouncementConfig(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_announcement_category": "missing"})
+	defer ts.Close()
+	rec.handle("POST /graphql", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data":{"repository":{"id":"R_1","discussionCategories":{"nodes":[]}}}}`)
+	})
+	err := repo.postAnnouncement(newReleaseNameData("2.0.0", "v2.0.0", "master"), "body", "")
+	require.EqualError(t, err, "discussion category missing not found in owner/test-repo")
+
+	repo = &GitHubRepository{}
+	err = repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_announcement_repo": "news"})
+	require.EqualError(t, err, "invalid github_announcement_repo: news")
+}
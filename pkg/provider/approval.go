@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/go-github/v66/github"
+)
+
+const defaultApprovalTimeout = time.Hour
+
+var approvalPollInterval = 15 * time.Second
+
+// waitForApproval creates a pending deployment of sha to the protected approval environment and waits until one of
+// its required reviewers approved (success) or rejected (failure, error or inactive) the deployment.
+func (repo *GitHubRepository) waitForApproval(tag, sha string) error {
+	deployment, _, err := repo.client.Repositories.CreateDeployment(context.Background(), repo.owner, repo.repo, &github.DeploymentRequest{
+		Ref:              github.String(sha),
+		Task:             github.String("approve-release"),
+		Environment:      github.String(repo.approvalEnvironment),
+		Description:      github.String(fmt.Sprintf("Approve release %s", tag)),
+		AutoMerge:        github.Bool(false),
+		RequiredContexts: &[]string{},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create approval deployment: %w", err)
+	}
+	log.Printf("waiting for the approval of %s in environment %s", tag, repo.approvalEnvironment)
+	deadline := time.Now().Add(repo.approvalTimeout)
+	for {
+		statuses, _, err := repo.client.Repositories.ListDeploymentStatuses(context.Background(), repo.owner, repo.repo, deployment.GetID(), &github.ListOptions{PerPage: 1})
+		if err != nil {
+			return fmt.Errorf("failed to get approval status: %w", err)
+		}
+		// the statuses are returned newest first
+		if len(statuses) > 0 {
+			switch state := statuses[0].GetState(); state {
+			case "success":
+				return nil
+			case "failure", "error", "inactive":
+				return fmt.Errorf("release %s was not approved (%s)", tag, state)
+			}
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for the approval of release %s after %s", tag, repo.approvalTimeout)
+		}
+		wait("approval", approvalPollInterval)
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubApproval(t *testing.T) {
+	defer func(interval time.Duration) { approvalPollInterval = interval }(approvalPollInterval)
+	approvalPollInterval = time.Millisecond
+
+	testCases := []struct {
+		statuses []string
+		err      string
+	}{
+		{[]string{"", "waiting", "success"}, ""},
+		{[]string{"waiting", "failure"}, "release v2.0.0 was not approved (failure)"},
+		{[]string{"waiting"}, "timed out waiting for the approval of release v2.0.0"},
+	}
+	for _, tc := range testCases {
+		repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+			"github_approval_environment": "release",
+			"github_approval_timeout":     "50ms",
+		})
+		var deployment map[string]interface{}
+		rec.handle("POST /repos/owner/test-repo/deployments", func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&deployment))
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":7}`)
+		})
+		polls := 0
+		rec.handle("GET /repos/owner/test-repo/deployments/7/statuses", func(w http.ResponseWriter, _ *http.Request) {
+			state := tc.statuses[len(tc.statuses)-1]
+			if polls < len(tc.statuses) {
+				state = tc.statuses[polls]
+			}
+			polls++
+			if state == "" {
+				fmt.Fprint(w, "[]")
+				return
+			}
+			fmt.Fprintf(w, `[{"state":%q}]`, state)
+		})
+
+		waited := mapValue(waits, "approval")
+		err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+		ts.Close()
+		require.Equal(t, testSHA, deployment["ref"])
+		require.Equal(t, "release", deployment["environment"])
+		if tc.err != "" {
+			require.ErrorContains(t, err, tc.err)
+			require.Nil(t, rec.lastRelease())
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, len(tc.statuses), polls)
+		require.Equal(t, waited+int64(polls-1), mapValue(waits, "approval"))
+		require.Equal(t, "v2.0.0", rec.lastRelease().GetTagName())
+	}
+}
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	archiveFormatZip   = "zip"
+	archiveFormatTarGz = "tar.gz"
+)
+
+// archiveNameData is passed to the templates of the archive names.
+type archiveNameData struct {
+	Repo    string
+	Version string
+	Tag     string
+}
+
+// assetArchive bundles files and directories into one release asset.
+type assetArchive struct {
+	Name   string   `yaml:"name"`
+	Format string   `yaml:"format"`
+	Files  []string `yaml:"files"`
+
+	nameTemplate *template.Template
+}
+
+type assetArchivesConfig struct {
+	Archives []*assetArchive `yaml:"archives"`
+}
+
+// archiveFormat returns the explicit format or the one of the file extension.
+func archiveFormat(format, name string) (string, error) {
+	switch {
+	case format == archiveFormatZip || format == archiveFormatTarGz:
+		return format, nil
+	case format == "tgz":
+		return archiveFormatTarGz, nil
+	case format != "":
+		return "", fmt.Errorf("unknown archive format %s", format)
+	case strings.HasSuffix(name, ".zip"):
+		return archiveFormatZip, nil
+	case strings.HasSuffix(name, ".tar.gz") || strings.HasSuffix(name, ".tgz"):
+		return archiveFormatTarGz, nil
+	}
+	return "", fmt.Errorf("unknown archive format of %s, set the format", name)
+}
+
+// splitAssetFormat splits the archive format off a github_assets entry, e.g. dist/app_*:zip packages each of the
+// matching files in a zip archive.
+func splitAssetFormat(entry string) (string, string) {
+	i := strings.LastIndex(entry, ":")
+	if i < 0 {
+		return entry, ""
+	}
+	format, err := archiveFormat(entry[i+1:], "")
+	if err != nil {
+		return entry, ""
+	}
+	return entry[:i], format
+}
+
+// parseAssetArchives parses the archives configuration (inline YAML or a file).
+func parseAssetArchives(rawConfig string) ([]*assetArchive, error) {
+	data, err := readInlineOrFile(rawConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read github_asset_archives: %w", err)
+	}
+	if data == "" {
+		return nil, nil
+	}
+	config := &assetArchivesConfig{}
+	if err := yaml.Unmarshal([]byte(data), config); err != nil {
+		return nil, fmt.Errorf("failed to parse github_asset_archives: %w", err)
+	}
+	for _, archive := range config.Archives {
+		if archive.Name == "" || len(archive.Files) == 0 {
+			return nil, errors.New("every archive of github_asset_archives requires a name and files")
+		}
+		archive.nameTemplate, err = template.New("archive").Parse(archive.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the name of archive %s: %w", archive.Name, err)
+		}
+		if archive.Format != "" {
+			if archive.Format, err = archiveFormat(archive.Format, ""); err != nil {
+				return nil, fmt.Errorf("invalid archive %s: %w", archive.Name, err)
+			}
+		}
+	}
+	return config.Archives, nil
+}
+
+// archiveEntry is a file that is added to an archive with the slash separated name.
+type archiveEntry struct {
+	name string
+	path string
+	info fs.FileInfo
+}
+
+// archiveEntries resolves the globs, files are added by their base name and directories recursively below their base
+// name.
+func archiveEntries(patterns []string) ([]*archiveEntry, error) {
+	files, err := globFiles(patterns)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*archiveEntry, 0, len(files))
+	seen := make(map[string]string)
+	add := func(name, path string, info fs.FileInfo) error {
+		if other, ok := seen[name]; ok {
+			if isSameFile(other, path) {
+				return nil
+			}
+			return fmt.Errorf("duplicate archive entry %s (%s and %s)", name, other, path)
+		}
+		seen[name] = path
+		entries = append(entries, &archiveEntry{name: name, path: path, info: info})
+		return nil
+	}
+	for _, file := range files {
+		root := filepath.Dir(file)
+		err := filepath.WalkDir(file, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			if !info.Mode().IsRegular() && !info.IsDir() {
+				return nil
+			}
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			return add(filepath.ToSlash(rel), path, info)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+	}
+	return entries, nil
+}
+
+func copyFile(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+func writeZip(w io.Writer, entries []*archiveEntry) error {
+	zw := zip.NewWriter(w)
+	for _, entry := range entries {
+		header, err := zip.FileInfoHeader(entry.info)
+		if err != nil {
+			return err
+		}
+		header.Name = entry.name
+		if entry.info.IsDir() {
+			header.Name += "/"
+		} else {
+			header.Method = zip.Deflate
+		}
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		if entry.info.IsDir() {
+			continue
+		}
+		if err := copyFile(fw, entry.path); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func writeTarGz(w io.Writer, entries []*archiveEntry) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	for _, entry := range entries {
+		header, err := tar.FileInfoHeader(entry.info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = entry.name
+		if entry.info.IsDir() {
+			header.Name += "/"
+		}
+		// the owner of the build machine is meaningless to the users of the release
+		header.Uid, header.Gid, header.Uname, header.Gname = 0, 0, "", ""
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if entry.info.IsDir() {
+			continue
+		}
+		if err := copyFile(tw, entry.path); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// createArchive writes the entries to a temporary archive that is uploaded as asset with the given name.
+func createArchive(name, format string, entries []*archiveEntry) (*releaseAsset, error) {
+	dir, err := os.MkdirTemp("", "semrel-archive-")
+	if err != nil {
+		return nil, err
+	}
+	asset := &releaseAsset{name: name, path: filepath.Join(dir, name), tempDir: dir}
+	f, err := os.Create(asset.path)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	if format == archiveFormatZip {
+		err = writeZip(f, entries)
+	} else {
+		err = writeTarGz(f, entries)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("failed to create archive %s: %w", name, err)
+	}
+	return asset, nil
+}
+
+// packageAsset packages a single asset in the format of its github_assets entry, e.g. app_linux_amd64 as
+// app_linux_amd64.zip.
+func packageAsset(asset *releaseAsset, format string) (*releaseAsset, error) {
+	info, err := os.Stat(asset.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read asset %s: %w", asset.path, err)
+	}
+	archive, err := createArchive(asset.name+"."+format, format, []*archiveEntry{{name: asset.name, path: asset.path, info: info}})
+	if err != nil {
+		return nil, err
+	}
+	removeTemporaryAssets([]*releaseAsset{asset})
+	return archive, nil
+}
+
+func (repo *GitHubRepository) createAssetArchive(archive *assetArchive, version, tag string) (*releaseAsset, error) {
+	name := &bytes.Buffer{}
+	if err := archive.nameTemplate.Execute(name, archiveNameData{Repo: repo.repo, Version: version, Tag: tag}); err != nil {
+		return nil, fmt.Errorf("failed to render the name of archive %s: %w", archive.Name, err)
+	}
+	format, err := archiveFormat(archive.Format, name.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive %s: %w", name.String(), err)
+	}
+	entries, err := archiveEntries(archive.Files)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive %s: %w", name.String(), err)
+	}
+	return createArchive(name.String(), format, entries)
+}
+
+// createAssetArchives creates the archives of github_asset_archives.
+func (repo *GitHubRepository) createAssetArchives(version, tag string) ([]*releaseAsset, error) {
+	archives := make([]*releaseAsset, 0, len(repo.assetArchives))
+	for _, archive := range repo.assetArchives {
+		asset, err := repo.createAssetArchive(archive, version, tag)
+		if err != nil {
+			removeTemporaryAssets(archives)
+			return nil, err
+		}
+		archives = append(archives, asset)
+	}
+	return archives, nil
+}
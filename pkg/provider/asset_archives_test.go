@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func readTarGz(t *testing.T, data []byte) map[string]string {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+	files := make(map[string]string)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[header.Name] = string(content)
+	}
+}
+
+func readZip(t *testing.T, data []byte) map[string]string {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = string(content)
+	}
+	return files
+}
+
+func TestArchiveFormat(t *testing.T) {
+	for name, expected := range map[string]string{"app.zip": archiveFormatZip, "app.tar.gz": archiveFormatTarGz, "app.tgz": archiveFormatTarGz} {
+		format, err := archiveFormat("", name)
+		require.NoError(t, err)
+		require.Equal(t, expected, format)
+	}
+	format, err := archiveFormat("tgz", "app")
+	require.NoError(t, err)
+	require.Equal(t, archiveFormatTarGz, format)
+	_, err = archiveFormat("", "app.tar.xz")
+	require.EqualError(t, err, "unknown archive format of app.tar.xz, set the format")
+	_, err = archiveFormat("rar", "app")
+	require.EqualError(t, err, "unknown archive format rar")
+}
+
+func TestSplitAssetFormat(t *testing.T) {
+	testCases := []struct {
+		entry  string
+		source string
+		format string
+	}{
+		{"dist/app_*:zip", "dist/app_*", archiveFormatZip},
+		{"dist/app_*:tgz", "dist/app_*", archiveFormatTarGz},
+		{"dist/app_*", "dist/app_*", ""},
+		{"https://ci.example.com/app:tar.gz", "https://ci.example.com/app", archiveFormatTarGz},
+		{"https://ci.example.com/app.zip", "https://ci.example.com/app.zip", ""},
+	}
+	for _, tc := range testCases {
+		source, format := splitAssetFormat(tc.entry)
+		require.Equal(t, tc.source, source)
+		require.Equal(t, tc.format, format)
+	}
+}
+
+func TestParseAssetArchives(t *testing.T) {
+	_, err := parseAssetArchives("archives:\n  - name: app.zip\n")
+	require.EqualError(t, err, "every archive of github_asset_archives requires a name and files")
+	_, err = parseAssetArchives("archives:\n  - name: app.zip\n    format: rar\n    files: [app]\n")
+	require.EqualError(t, err, "invalid archive app.zip: unknown archive format rar")
+	archives, err := parseAssetArchives("")
+	require.NoError(t, err)
+	require.Nil(t, archives)
+}
+
+func TestGithubAssetArchives(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app"), []byte("binary"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tool"), []byte("tool"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "install.sh"), []byte("installer"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("readme"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "docs", "guide"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "guide", "index.md"), []byte("guide"), 0o600))
+
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_assets": filepath.Join(dir, "app") + ":zip," + filepath.Join(dir, "tool") + ":tgz," +
+			filepath.Join(dir, "install.sh"),
+		"github_asset_archives": "archives:\n" +
+			"  - name: \"{{.Repo}}_{{.Version}}.tar.gz\"\n" +
+			"    files: [" + filepath.Join(dir, "app") + ", " + filepath.Join(dir, "*.md") + ", " + filepath.Join(dir, "docs") + "]\n",
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+
+	data, ok := rec.get("app.zip")
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"app": "binary"}, readZip(t, data))
+	_, ok = rec.get("app")
+	require.False(t, ok)
+	data, ok = rec.get("tool.tar.gz")
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"tool": "tool"}, readTarGz(t, data))
+	data, ok = rec.get("install.sh")
+	require.True(t, ok)
+	require.Equal(t, "installer", string(data))
+
+	data, ok = rec.get("test-repo_2.0.0.tar.gz")
+	require.True(t, ok)
+	require.Equal(t, map[string]string{
+		"app":                 "binary",
+		"README.md":           "readme",
+		"docs/":               "",
+		"docs/guide/":         "",
+		"docs/guide/index.md": "guide",
+	}, readTarGz(t, data))
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	// e.g. "<sha256>  dist/app.tar.gz" of sha256sum, a * in front of the name marks binary mode
+	gnuChecksumRe = regexp.MustCompile(`^([0-9a-fA-F]{64})\s+\*?(.+)$`)
+	// e.g. "SHA256 (dist/app.tar.gz) = <sha256>" of shasum --tag and BSD sha256
+	bsdChecksumRe = regexp.MustCompile(`^SHA256 \((.+)\) = ([0-9a-fA-F]{64})$`)
+)
+
+// parseChecksumManifest returns the SHA-256 checksums of a manifest in the format of sha256sum or shasum --tag by
+// base name of the files.
+func parseChecksumManifest(data []byte) (map[string]string, error) {
+	checksums := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var name, sum string
+		if m := gnuChecksumRe.FindStringSubmatch(line); m != nil {
+			sum, name = m[1], m[2]
+		} else if m := bsdChecksumRe.FindStringSubmatch(line); m != nil {
+			name, sum = m[1], m[2]
+		} else {
+			return nil, fmt.Errorf("invalid checksum line: %s", line)
+		}
+		checksums[filepath.Base(name)] = strings.ToLower(sum)
+	}
+	return checksums, scanner.Err()
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func verifyAssetChecksum(asset *releaseAsset, expected string) error {
+	actual, err := fileSHA256(asset.path)
+	if err != nil {
+		return fmt.Errorf("failed to read asset %s: %w", asset.path, err)
+	}
+	if actual != expected {
+		return fmt.Errorf("checksum mismatch of asset %s: expected %s, got %s", asset.path, expected, actual)
+	}
+	return nil
+}
+
+// verifyAssetChecksums verifies the assets against the manifest (github_assets_manifest, defaulting to
+// github_checksums_file), every asset has to be listed. Without a manifest the assets are verified against
+// <asset>.sha256 files next to them if they exist.
+func (repo *GitHubRepository) verifyAssetChecksums(assets []*releaseAsset) error {
+	if len(assets) == 0 {
+		return nil
+	}
+	manifest := repo.assetsManifest
+	if manifest == "" {
+		manifest = repo.checksumsFile
+	}
+	if manifest == "" {
+		for _, asset := range assets {
+			data, err := os.ReadFile(asset.path + ".sha256")
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read checksum of asset %s: %w", asset.path, err)
+			}
+			fields := strings.Fields(string(data))
+			if len(fields) == 0 {
+				return fmt.Errorf("empty checksum file %s.sha256", asset.path)
+			}
+			if err := verifyAssetChecksum(asset, strings.ToLower(fields[0])); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	data, err := os.ReadFile(manifest)
+	if err != nil {
+		return fmt.Errorf("failed to read checksum manifest: %w", err)
+	}
+	checksums, err := parseChecksumManifest(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse checksum manifest %s: %w", manifest, err)
+	}
+	for _, asset := range assets {
+		expected, ok := checksums[asset.name]
+		if !ok {
+			return fmt.Errorf("asset %s is not listed in the checksum manifest %s", asset.path, manifest)
+		}
+		if err := verifyAssetChecksum(asset, expected); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChecksumManifest(t *testing.T) {
+	linux := fmt.Sprintf("%x", sha256.Sum256([]byte("linux")))
+	darwin := fmt.Sprintf("%x", sha256.Sum256([]byte("darwin")))
+	manifest := fmt.Sprintf("# checksums\n%s  dist/app_linux\n\nSHA256 (dist/app_darwin) = %s\n", linux, darwin)
+	checksums, err := parseChecksumManifest([]byte(manifest))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"app_linux": linux, "app_darwin": darwin}, checksums)
+
+	_, err = parseChecksumManifest([]byte("deadbeef  app\n"))
+	require.EqualError(t, err, "invalid checksum line: deadbeef  app")
+}
+
+func writeTestAssets(t *testing.T) string {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app_linux"), []byte("linux"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app_darwin"), []byte("darwin"), 0o600))
+	return dir
+}
+
+func TestGithubVerifyAssetChecksums(t *testing.T) {
+	dir := writeTestAssets(t)
+	manifest := filepath.Join(dir, "SHA256SUMS")
+	repo := &GitHubRepository{assetFiles: []string{filepath.Join(dir, "*")}, assetsManifest: manifest}
+
+	linux := fmt.Sprintf("%x", sha256.Sum256([]byte("linux")))
+	darwin := fmt.Sprintf("%x", sha256.Sum256([]byte("darwin")))
+	require.NoError(t, os.WriteFile(manifest, []byte(fmt.Sprintf("%s  app_linux\n%s *app_darwin\n", linux, darwin)), 0o600))
+	assets, err := repo.collectAssets("2.0.0", "v2.0.0")
+	require.NoError(t, err)
+	require.Len(t, assets, 2)
+
+	require.NoError(t, os.WriteFile(manifest, []byte(fmt.Sprintf("%s  app_linux\n", linux)), 0o600))
+	_, err = repo.collectAssets("2.0.0", "v2.0.0")
+	require.ErrorContains(t, err, "app_darwin is not listed in the checksum manifest")
+
+	require.NoError(t, os.WriteFile(manifest, []byte(fmt.Sprintf("%s  app_linux\n%s  app_darwin\n", linux, linux)), 0o600))
+	_, err = repo.collectAssets("2.0.0", "v2.0.0")
+	require.ErrorContains(t, err, "checksum mismatch of asset "+filepath.Join(dir, "app_darwin"))
+}
+
+func TestGithubVerifyAssetSidecarChecksums(t *testing.T) {
+	dir := writeTestAssets(t)
+	repo := &GitHubRepository{assetFiles: []string{filepath.Join(dir, "app_*")}}
+	linux := fmt.Sprintf("%x", sha256.Sum256([]byte("linux")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app_linux.sha256"), []byte(linux+"  app_linux\n"), 0o600))
+	_, err := repo.collectAssets("2.0.0", "v2.0.0")
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app_darwin.sha256"), []byte(linux+"\n"), 0o600))
+	_, err = repo.collectAssets("2.0.0", "v2.0.0")
+	require.ErrorContains(t, err, "checksum mismatch of asset")
+}
+
+func TestGithubAssetChecksumMismatchAbortsRelease(t *testing.T) {
+	dir := writeTestAssets(t)
+	checksumsFile := filepath.Join(dir, "checksums.txt")
+	require.NoError(t, os.WriteFile(checksumsFile, []byte(fmt.Sprintf("%x  app_linux\n", sha256.Sum256([]byte("tampered")))), 0o600))
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_assets":         filepath.Join(dir, "app_linux"),
+		"github_checksums_file": checksumsFile,
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.ErrorContains(t, err, "checksum mismatch of asset")
+	require.Nil(t, rec.lastRelease())
+}
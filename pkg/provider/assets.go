@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+)
+
+type uploadedAsset struct {
+	name   string
+	sha256 string
+}
+
+func assetMediaType(name string) string {
+	if mediaType := mime.TypeByExtension(filepath.Ext(name)); mediaType != "" {
+		return mediaType
+	}
+	return "application/octet-stream"
+}
+
+func (repo *GitHubRepository) doUploadReleaseAsset(releaseID int64, name string, content io.Reader, size int64) (string, error) {
+	hash := sha256.New()
+	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets?name=%s", repo.releaseOwner, repo.releaseRepo, releaseID, url.QueryEscape(name))
+	req, err := repo.client.NewUploadRequest(u, io.TeeReader(content, hash), size, assetMediaType(name))
+	if err != nil {
+		return "", err
+	}
+	_, err = repo.client.Do(context.Background(), req, nil)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// isAlreadyExistsError reports whether the API rejected the request because the resource already exists.
+func isAlreadyExistsError(err error) bool {
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil || errResp.Response.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	for _, e := range errResp.Errors {
+		// e.g. "A pull request already exists for owner:branch" is reported as custom error
+		if e.Code == "already_exists" || strings.Contains(strings.ToLower(e.Message), "already exists") {
+			return true
+		}
+	}
+	return strings.Contains(strings.ToLower(errResp.Message), "already exists")
+}
+
+func (repo *GitHubRepository) uploadReleaseAsset(releaseID int64, name string, content io.ReadSeeker, size int64) (*uploadedAsset, error) {
+	digest, err := repo.doUploadReleaseAsset(releaseID, name, content, size)
+	if isAlreadyExistsError(err) {
+		// the asset was uploaded by a previous run, replace it
+		countRetry("upload_asset")
+		if err := repo.deleteReleaseAssetByName(releaseID, name); err != nil {
+			return nil, fmt.Errorf("failed to replace asset %s: %w", name, err)
+		}
+		if _, err := content.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		digest, err = repo.doUploadReleaseAsset(releaseID, name, content, size)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload asset %s: %w", name, err)
+	}
+	return &uploadedAsset{name: name, sha256: digest}, nil
+}
+
+func (repo *GitHubRepository) uploadChecksums(releaseID int64) ([]*uploadedAsset, error) {
+	if repo.checksumsFile == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(repo.checksumsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checksums file: %w", err)
+	}
+	name := filepath.Base(repo.checksumsFile)
+	files := map[string][]byte{name: data}
+	fileNames := []string{name}
+	if repo.gpgEntity != nil {
+		sig, err := gpgDetachSign(repo.gpgEntity, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign checksums file: %w", err)
+		}
+		files[name+".asc"] = sig
+		fileNames = append(fileNames, name+".asc")
+	}
+	if repo.minisignKey != nil {
+		files[name+".minisig"] = minisignSign(repo.minisignKey, name, data)
+		fileNames = append(fileNames, name+".minisig")
+	}
+	assets := make([]*uploadedAsset, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		asset, err := repo.uploadReleaseAsset(releaseID, fileName, bytes.NewReader(files[fileName]), int64(len(files[fileName])))
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, asset)
+	}
+	return assets, nil
+}
+
+func (repo *GitHubRepository) uploadAssets(release *provider.CreateReleaseConfig, tag string, releaseID int64, fileAssets []*releaseAsset) error {
+	assets, err := repo.uploadFileAssets(releaseID, fileAssets)
+	if err != nil {
+		return err
+	}
+	if repo.sourceArchive {
+		asset, err := repo.uploadSourceArchive(release.SHA, release.NewVersion, releaseID)
+		if err != nil {
+			return err
+		}
+		assets = append(assets, asset)
+	}
+	checksumAssets, err := repo.uploadChecksums(releaseID)
+	if err != nil {
+		return err
+	}
+	assets = append(assets, checksumAssets...)
+	if !repo.provenance || len(assets) == 0 {
+		return nil
+	}
+	statement, err := createProvenanceStatement(release, tag, repo.owner+"/"+repo.repo, assets)
+	if err != nil {
+		return fmt.Errorf("failed to create provenance: %w", err)
+	}
+	_, err = repo.uploadReleaseAsset(releaseID, provenanceAssetName, bytes.NewReader(statement), int64(len(statement)))
+	return err
+}
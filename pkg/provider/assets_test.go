@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubUploadSignedChecksums(t *testing.T) {
+	checksumsFile := filepath.Join(t.TempDir(), "checksums.txt")
+	checksums := []byte("deadbeef  provider-github_linux_amd64\n")
+	require.NoError(t, os.WriteFile(checksumsFile, checksums, 0o600))
+	key, _ := createTestGPGKey(t, "")
+	minisignKey, _ := createTestMinisignKey(t, "")
+
+	repo, ts, uploads := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_checksums_file": checksumsFile,
+		"gpg_private_key":       key,
+		"minisign_private_key":  minisignKey,
+		"github_provenance":     "true",
+	})
+	defer ts.Close()
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+
+	data, ok := uploads.get("checksums.txt")
+	require.True(t, ok)
+	require.Equal(t, checksums, data)
+	_, ok = uploads.get("checksums.txt.asc")
+	require.True(t, ok)
+	_, ok = uploads.get("checksums.txt.minisig")
+	require.True(t, ok)
+
+	data, ok = uploads.get(provenanceAssetName)
+	require.True(t, ok)
+	statement := &inTotoStatement{}
+	require.NoError(t, json.Unmarshal(data, statement))
+	require.Len(t, statement.Subject, 3)
+	require.Equal(t, "checksums.txt", statement.Subject[0].Name)
+	require.Equal(t, fmt.Sprintf("%x", sha256.Sum256(checksums)), statement.Subject[0].Digest["sha256"])
+	require.Equal(t, testSHA, statement.Predicate.BuildDefinition.ResolvedDependencies[0].Digest["gitCommit"])
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// auditOperations names the mutating requests by method and path, requests that do not match are recorded with
+// the operation "other".
+var auditOperations = []struct {
+	method    string
+	path      *regexp.Regexp
+	operation string
+}{
+	{http.MethodPost, regexp.MustCompile(`/git/refs$`), "ref_created"},
+	{http.MethodPatch, regexp.MustCompile(`/git/refs/`), "ref_updated"},
+	{http.MethodDelete, regexp.MustCompile(`/git/refs/`), "ref_deleted"},
+	{http.MethodPost, regexp.MustCompile(`/git/tags$`), "tag_object_created"},
+	{http.MethodPost, regexp.MustCompile(`/git/commits$`), "commit_created"},
+	{http.MethodPut, regexp.MustCompile(`/contents/`), "file_committed"},
+	{http.MethodPost, regexp.MustCompile(`/releases$`), "release_created"},
+	{http.MethodPatch, regexp.MustCompile(`/releases/\d+$`), "release_edited"},
+	{http.MethodDelete, regexp.MustCompile(`/releases/\d+$`), "release_deleted"},
+	{http.MethodPost, regexp.MustCompile(`/releases/\d+/assets$`), "asset_uploaded"},
+	{http.MethodDelete, regexp.MustCompile(`/releases/assets/\d+$`), "asset_deleted"},
+	{http.MethodPost, regexp.MustCompile(`/issues/\d+/comments$`), "comment_posted"},
+	{http.MethodPost, regexp.MustCompile(`/issues$`), "issue_created"},
+	{http.MethodPatch, regexp.MustCompile(`/issues/\d+$`), "issue_edited"},
+	{http.MethodPost, regexp.MustCompile(`/pulls$`), "pull_request_created"},
+	{http.MethodPost, regexp.MustCompile(`/graphql$`), "graphql_mutation"},
+}
+
+type auditEntry struct {
+	Time      string `json:"time"`
+	Operation string `json:"operation"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Status    int    `json:"status,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// auditTransport appends every mutating request to a JSON lines file. GraphQL requests are only recorded if they
+// are mutations.
+type auditTransport struct {
+	base http.RoundTripper
+	path string
+	mu   sync.Mutex
+}
+
+func newAuditTransport(base http.RoundTripper, path string) *auditTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &auditTransport{base: base, path: path}
+}
+
+func auditOperation(r *http.Request) string {
+	for _, op := range auditOperations {
+		if op.method == r.Method && op.path.MatchString(r.URL.Path) {
+			return op.operation
+		}
+	}
+	return "other"
+}
+
+// isGraphQLMutation peeks at the query of a copy of the GraphQL request body, a RoundTripper must not modify the
+// request. Bodies that can not be copied are treated as mutation, so that no mutation is missing in the audit log.
+func isGraphQLMutation(r *http.Request) bool {
+	if r.Body == nil || r.Body == http.NoBody {
+		return false
+	}
+	if r.GetBody == nil {
+		return true
+	}
+	body, err := r.GetBody()
+	if err != nil {
+		return true
+	}
+	defer body.Close()
+	var req graphQLRequest
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		return false
+	}
+	return strings.HasPrefix(strings.TrimSpace(req.Query), "mutation")
+}
+
+func (t *auditTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return t.base.RoundTrip(r)
+	}
+	operation := auditOperation(r)
+	if operation == "graphql_mutation" && !isGraphQLMutation(r) {
+		return t.base.RoundTrip(r)
+	}
+	resp, err := t.base.RoundTrip(r)
+	entry := &auditEntry{
+		Time:      time.Now().UTC().Format(time.RFC3339Nano),
+		Operation: operation,
+		Method:    r.Method,
+		Path:      r.URL.Path,
+	}
+	if resp != nil {
+		entry.Status = resp.StatusCode
+		entry.RequestID = resp.Header.Get("X-GitHub-Request-Id")
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	t.write(entry)
+	return resp, err
+}
+
+// write appends the entry to the audit log, failures are only logged so that the release itself is not affected.
+func (t *auditTransport) write(entry *auditEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("warning: failed to encode audit log entry: %v", err)
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	f, err := os.OpenFile(t.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		log.Printf("warning: failed to open audit log: %v", err)
+		return
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		log.Printf("warning: failed to write audit log: %v", err)
+	}
+}
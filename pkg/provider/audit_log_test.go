@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func readAuditLog(t *testing.T, path string) []auditEntry {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	entries := make([]auditEntry, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry auditEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func TestGithubAuditLog(t *testing.T) {
+	auditLog := filepath.Join(t.TempDir(), "audit.jsonl")
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_audit_log": auditLog})
+	defer ts.Close()
+	rec.handle("POST /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-GitHub-Request-Id", "CAFE:1")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":1}`))
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	entries := readAuditLog(t, auditLog)
+	require.Len(t, entries, 2)
+	require.Equal(t, "ref_created", entries[0].Operation)
+	require.Equal(t, "/repos/owner/test-repo/git/refs", entries[0].Path)
+	require.Equal(t, http.StatusOK, entries[0].Status)
+	require.Equal(t, auditEntry{
+		Time:      entries[1].Time,
+		Operation: "release_created",
+		Method:    http.MethodPost,
+		Path:      "/repos/owner/test-repo/releases",
+		Status:    http.StatusCreated,
+		RequestID: "CAFE:1",
+	}, entries[1])
+	require.NotEmpty(t, entries[1].Time)
+}
+
+func TestIsGraphQLMutation(t *testing.T) {
+	for query, mutation := range map[string]bool{
+		"query { viewer { login } }":                       false,
+		"\n mutation($id: ID!) { pinIssue(input: {}) {} }": true,
+	} {
+		body, err := json.Marshal(&graphQLRequest{Query: query})
+		require.NoError(t, err)
+		r, err := http.NewRequest(http.MethodPost, "https://api.github.com/graphql", strings.NewReader(string(body)))
+		require.NoError(t, err)
+		original := r.Body
+		require.Equal(t, mutation, isGraphQLMutation(r))
+		// the request is not modified and the body can still be sent
+		require.True(t, original == r.Body)
+		sent, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, body, sent)
+	}
+
+	// a body that can not be copied is audited
+	r, err := http.NewRequest(http.MethodPost, "https://api.github.com/graphql", strings.NewReader("{}"))
+	require.NoError(t, err)
+	r.GetBody = nil
+	require.True(t, isGraphQLMutation(r))
+}
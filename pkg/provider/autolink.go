@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// autolinkRe matches issue references (#123, GH-123, owner/repo#123) and commit SHAs (optionally prefixed
+// with owner/repo@). Inline code, markdown links and URLs are matched first so that they are left untouched.
+var autolinkRe = regexp.MustCompile("(?m)(`[^`]*`|\\[[^\\]]*\\]\\([^)]*\\)|https?://[^\\s)>]+)" +
+	`|(^|[^\w/&])(?:([\w.-]+/[\w.-]+)#|(#|GH-))(\d+)\b` +
+	`|\b(?:([\w.-]+/[\w.-]+)@)?([0-9a-f]{40})\b`)
+
+func (repo *GitHubRepository) autolink(body string) string {
+	baseURL := fmt.Sprintf("https://%s/", repo.webHost())
+	slug := repo.owner + "/" + repo.repo
+	sb := &strings.Builder{}
+	last := 0
+	for _, m := range autolinkRe.FindAllStringSubmatchIndex(body, -1) {
+		group := func(i int) string {
+			if m[2*i] < 0 {
+				return ""
+			}
+			return body[m[2*i]:m[2*i+1]]
+		}
+		sb.WriteString(body[last:m[0]])
+		last = m[1]
+		switch {
+		case m[2] >= 0:
+			sb.WriteString(group(1))
+		case m[10] >= 0:
+			targetSlug, text := slug, group(4)+group(5)
+			if crossRepo := group(3); crossRepo != "" {
+				targetSlug, text = crossRepo, crossRepo+"#"+group(5)
+			}
+			fmt.Fprintf(sb, "%s[%s](%s%s/issues/%s)", group(2), text, baseURL, targetSlug, group(5))
+		default:
+			sha := group(7)
+			targetSlug, text := slug, sha[:7]
+			if crossRepo := group(6); crossRepo != "" {
+				targetSlug, text = crossRepo, crossRepo+"@"+sha[:7]
+			}
+			fmt.Fprintf(sb, "[%s](%s%s/commit/%s)", text, baseURL, targetSlug, sha)
+		}
+	}
+	sb.WriteString(body[last:])
+	return sb.String()
+}
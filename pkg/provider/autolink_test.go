@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutolink(t *testing.T) {
+	repo := &GitHubRepository{}
+	require.NoError(t, repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token"}))
+	sha := "0123456789abcdef0123456789abcdef01234567"
+
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"fix: bug (#12)", "fix: bug ([#12](https://github.com/owner/test-repo/issues/12))"},
+		{"#12 at start", "[#12](https://github.com/owner/test-repo/issues/12) at start"},
+		{"closes GH-7", "closes [GH-7](https://github.com/owner/test-repo/issues/7)"},
+		{"see other/repo#3", "see [other/repo#3](https://github.com/other/repo/issues/3)"},
+		{"* " + sha + " feat", "* [0123456](https://github.com/owner/test-repo/commit/" + sha + ") feat"},
+		{"other/repo@" + sha, "[other/repo@0123456](https://github.com/other/repo/commit/" + sha + ")"},
+		{"[#12](https://example.com/12)", "[#12](https://example.com/12)"},
+		{"`#12` and https://github.com/owner/test-repo/commit/" + sha, "`#12` and https://github.com/owner/test-repo/commit/" + sha},
+		{"## Heading\nfoo#12 &#12; #abc", "## Heading\nfoo#12 &#12; #abc"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			require.Equal(t, tc.expected, repo.autolink(tc.input))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	badgeAssetName    = "badge.json"
+	defaultBadgeLabel = "release"
+)
+
+// shieldsBadge is the endpoint badge schema of shields.io.
+type shieldsBadge struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color"`
+}
+
+func renderBadge(label, tag string, prerelease bool) []byte {
+	color := "blue"
+	if prerelease {
+		color = "orange"
+	}
+	data, _ := json.MarshalIndent(&shieldsBadge{SchemaVersion: 1, Label: label, Message: tag, Color: color}, "", "  ")
+	return append(data, '\n')
+}
+
+// publishBadge attaches the badge of the release as asset and commits it to the configured path, the committed
+// badge is only updated by releases that become the latest release.
+func (repo *GitHubRepository) publishBadge(releaseID int64, tag, branch string, prerelease, latest bool) error {
+	badge := renderBadge(repo.badgeLabel, tag, prerelease)
+	if _, err := repo.uploadReleaseAsset(releaseID, badgeAssetName, bytes.NewReader(badge), int64(len(badge))); err != nil {
+		return err
+	}
+	if repo.badgePath == "" || prerelease || !latest {
+		return nil
+	}
+	if repo.badgeBranch != "" {
+		branch = repo.badgeBranch
+	}
+	content, sha, err := repo.readFile(repo.badgePath, branch)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", repo.badgePath, err)
+	}
+	if content == string(badge) {
+		return nil
+	}
+	return repo.commitFile(branch, repo.badgePath, fmt.Sprintf("docs: update badge for %s [skip ci]", tag), sha, badge)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderBadge(t *testing.T) {
+	var badge shieldsBadge
+	require.NoError(t, json.Unmarshal(renderBadge("release", "v1.0.0-rc.1", true), &badge))
+	require.Equal(t, shieldsBadge{SchemaVersion: 1, Label: "release", Message: "v1.0.0-rc.1", Color: "orange"}, badge)
+}
+
+func TestGithubBadge(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_badge":        "true",
+		"github_badge_label":  "version",
+		"github_badge_path":   ".github/badge.json",
+		"github_badge_branch": "badges",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/contents/.github/badge.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "badges", r.URL.Query().Get("ref"))
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+	var update *github.RepositoryContentFileOptions
+	rec.handle("PUT /repos/owner/test-repo/contents/.github/badge.json", func(w http.ResponseWriter, r *http.Request) {
+		update = &github.RepositoryContentFileOptions{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(update))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("{}"))
+	})
+
+	// 2020.04.19 is a higher stable version, so the badge of 2.0.0 is not committed
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	asset, ok := rec.get(badgeAssetName)
+	require.True(t, ok)
+	require.Equal(t, string(renderBadge("version", "v2.0.0", false)), string(asset))
+	require.Nil(t, update)
+
+	require.NoError(t, repo.publishBadge(1, "v3.0.0", "main", false, true))
+	require.Equal(t, "badges", update.GetBranch())
+	require.Nil(t, update.SHA)
+	require.Equal(t, string(renderBadge("version", "v3.0.0", false)), string(update.Content))
+}
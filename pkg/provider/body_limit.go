@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxReleaseBodyLength is the maximum number of characters GitHub accepts for a release body.
+	maxReleaseBodyLength = 125000
+
+	releaseBodyOverflowTruncate = "truncate"
+	releaseBodyOverflowAsset    = "asset"
+
+	fullChangelogAssetName = "CHANGELOG.md"
+)
+
+// truncateToLength cuts s at the last line break so that it contains at most maxLength characters.
+func truncateToLength(s string, maxLength int) string {
+	if utf8.RuneCountInString(s) <= maxLength {
+		return s
+	}
+	runes := []rune(s)
+	truncated := string(runes[:maxLength])
+	if idx := strings.LastIndex(truncated, "\n"); idx > 0 {
+		truncated = truncated[:idx]
+	}
+	return truncated
+}
+
+// limitReleaseBody shortens bodies exceeding GitHub's size limit and adds a note where the full changelog can be found.
+func (repo *GitHubRepository) limitReleaseBody(newVersion, tag, body string) (string, error) {
+	if utf8.RuneCountInString(body) <= maxReleaseBodyLength {
+		return body, nil
+	}
+	note := ""
+	if repo.releaseBodyOverflow == releaseBodyOverflowAsset {
+		note = fmt.Sprintf("_The release notes were truncated, see the attached [%s](%s/releases/download/%s/%s) for the full changelog._",
+			fullChangelogAssetName, repo.releaseRepoURL(), tag, fullChangelogAssetName)
+	} else {
+		previousTag, err := repo.findPreviousTag(newVersion)
+		if err != nil {
+			return "", fmt.Errorf("failed to find previous tag: %w", err)
+		}
+		fullChangelogURL := fmt.Sprintf("%s/commits/%s", repo.repoURL(), tag)
+		if previousTag != "" {
+			fullChangelogURL = repo.compareURL(previousTag, tag)
+		}
+		note = fmt.Sprintf("_The release notes were truncated, see the [full changelog](%s)._", fullChangelogURL)
+	}
+	truncated := truncateToLength(body, maxReleaseBodyLength-utf8.RuneCountInString(note)-len("\n\n…\n\n"))
+	return truncated + "\n\n…\n\n" + note, nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTruncateToLength(t *testing.T) {
+	require.Equal(t, "short", truncateToLength("short", 10))
+	require.Equal(t, "line 1", truncateToLength("line 1\nline 2", 10))
+	require.Equal(t, "äöüäö", truncateToLength("äöüäöüäöü", 5))
+}
+
+func TestGithubOversizedReleaseBody(t *testing.T) {
+	changelog := strings.Repeat("* feat: a new feature\n", maxReleaseBodyLength/10)
+
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: changelog})
+	require.NoError(t, err)
+	body := rec.lastRelease().GetBody()
+	require.LessOrEqual(t, utf8.RuneCountInString(body), maxReleaseBodyLength)
+	require.True(t, strings.HasSuffix(body, "see the [full changelog]("+repo.repoURL()+"/compare/v1.1.1...v2.0.0)._"))
+
+	repo, ts, rec = getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_release_body_overflow": "asset",
+	})
+	defer ts.Close()
+	err = repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: changelog})
+	require.NoError(t, err)
+	require.LessOrEqual(t, utf8.RuneCountInString(rec.lastRelease().GetBody()), maxReleaseBodyLength)
+	require.Contains(t, rec.lastRelease().GetBody(), "see the attached [CHANGELOG.md]")
+	fullChangelog, ok := rec.get(fullChangelogAssetName)
+	require.True(t, ok)
+	require.Equal(t, changelog, string(fullChangelog))
+}
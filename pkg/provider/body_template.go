@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+)
+
+// releaseBodyData is passed to the release body template.
+type releaseBodyData struct {
+	Changelog   string
+	Version     string
+	Tag         string
+	PreviousTag string
+	Branch      string
+	SHA         string
+	Owner       string
+	Repo        string
+	RepoURL     string
+	CompareURL  string
+	Codename    string
+}
+
+func parseReleaseBodyTemplate(rawTemplate, templateFile string) (*template.Template, error) {
+	if templateFile != "" {
+		data, err := os.ReadFile(templateFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read github_release_body_template_file: %w", err)
+		}
+		rawTemplate = string(data)
+	}
+	if rawTemplate == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New("body").Parse(rawTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse release body template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// repoURL returns the web URL of the repository.
+func (repo *GitHubRepository) repoURL() string {
+	return fmt.Sprintf("https://%s/%s/%s", repo.webHost(), repo.owner, repo.repo)
+}
+
+// releaseRepoURL returns the web URL of the repository the GitHub releases are created in.
+func (repo *GitHubRepository) releaseRepoURL() string {
+	return fmt.Sprintf("https://%s/%s/%s", repo.webHost(), repo.releaseOwner, repo.releaseRepo)
+}
+
+// commitURL returns the web URL of the commit, it is constructed if the API did not return it.
+func (repo *GitHubRepository) commitURL(commit *github.RepositoryCommit) string {
+	if htmlURL := commit.GetHTMLURL(); htmlURL != "" {
+		return htmlURL
+	}
+	return fmt.Sprintf("%s/commit/%s", repo.repoURL(), commit.GetSHA())
+}
+
+func (repo *GitHubRepository) compareURL(previousTag, tag string) string {
+	if previousTag == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/compare/%s...%s", repo.repoURL(), previousTag, tag)
+}
+
+func (repo *GitHubRepository) renderReleaseBody(release *provider.CreateReleaseConfig, tag, previousTag, changelog string) (string, error) {
+	sb := &strings.Builder{}
+	err := repo.releaseBodyTemplate.Execute(sb, releaseBodyData{
+		Changelog:   changelog,
+		Version:     release.NewVersion,
+		Tag:         tag,
+		PreviousTag: previousTag,
+		Branch:      release.Branch,
+		SHA:         release.SHA,
+		Owner:       repo.owner,
+		Repo:        repo.repo,
+		RepoURL:     repo.repoURL(),
+		CompareURL:  repo.compareURL(previousTag, tag),
+		Codename:    codename(release.NewVersion),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to render release body template: %w", err)
+	}
+	return sb.String(), nil
+}
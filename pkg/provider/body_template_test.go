@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubReleaseBodyTemplate(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_release_body_template": "## Install\n`go install {{.Repo}}@{{.Tag}}`\n\n{{.Changelog}}\n{{.CompareURL}}",
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog"})
+	require.NoError(t, err)
+	expected := "## Install\n`go install test-repo@v2.0.0`\n\nchangelog\n" + repo.repoURL() + "/compare/v1.1.1...v2.0.0"
+	require.Equal(t, expected, rec.lastRelease().GetBody())
+}
+
+func TestParseReleaseBodyTemplate(t *testing.T) {
+	tmpl, err := parseReleaseBodyTemplate("", "")
+	require.NoError(t, err)
+	require.Nil(t, tmpl)
+
+	_, err = parseReleaseBodyTemplate("{{.Changelog", "")
+	require.ErrorContains(t, err, "failed to parse release body template")
+
+	templateFile := filepath.Join(t.TempDir(), "body.tmpl")
+	require.NoError(t, os.WriteFile(templateFile, []byte("{{.Version}}"), 0o600))
+	tmpl, err = parseReleaseBodyTemplate("ignored", templateFile)
+	require.NoError(t, err)
+	sb := &strings.Builder{}
+	require.NoError(t, tmpl.Execute(sb, releaseBodyData{Version: "1.0.0"}))
+	require.Equal(t, "1.0.0", sb.String())
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+const (
+	defaultChangelogFile  = "CHANGELOG.md"
+	changelogUpdatePR     = "pr"
+	changelogUpdateCommit = "commit"
+)
+
+const enableAutoMergeMutation = `mutation($pullRequestId: ID!) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $pullRequestId}) { pullRequest { number } }
+}`
+
+// insertChangelogSection inserts the section of the new release above the previous releases, a leading top-level
+// heading (e.g. "# Changelog") is kept at the top of the file.
+func insertChangelogSection(content, section string) string {
+	section = strings.TrimSpace(section) + "\n"
+	if strings.TrimSpace(content) == "" {
+		return section
+	}
+	if strings.HasPrefix(content, "# ") {
+		title, rest, _ := strings.Cut(content, "\n")
+		return title + "\n\n" + section + "\n" + strings.TrimLeft(rest, "\n")
+	}
+	return section + "\n" + content
+}
+
+// readFile returns the content and the blob SHA of the file at ref, both are empty if the file does not exist.
+func (repo *GitHubRepository) readFile(path, ref string) (string, string, error) {
+	file, _, resp, err := repo.client.Repositories.GetContents(context.Background(), repo.owner, repo.repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return "", "", err
+	}
+	return content, file.GetSHA(), nil
+}
+
+// commitChangelog adds the section to the changelog file on branch, nothing is committed if the file already
+// contains the section, e.g. when a failed release is retried.
+func (repo *GitHubRepository) commitChangelog(branch, message, section string) error {
+	content, sha, err := repo.readFile(repo.changelogFile, branch)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", repo.changelogFile, err)
+	}
+	if strings.Contains(content, strings.TrimSpace(section)) {
+		return nil
+	}
+	return repo.commitFile(branch, repo.changelogFile, message, sha, []byte(insertChangelogSection(content, section)))
+}
+
+// commitFile creates or (if sha is set) updates the file on branch using the Contents API.
+func (repo *GitHubRepository) commitFile(branch, path, message, sha string, content []byte) error {
+	opts := &github.RepositoryContentFileOptions{
+		Message: github.String(message),
+		Content: content,
+		Branch:  github.String(branch),
+	}
+	if sha != "" {
+		opts.SHA = github.String(sha)
+	}
+	_, _, err := repo.client.Repositories.UpdateFile(context.Background(), repo.owner, repo.repo, path, opts)
+	if err != nil {
+		return fmt.Errorf("failed to commit %s: %w", path, err)
+	}
+	return nil
+}
+
+// openChangelogPullRequest commits the changelog of the release to the branch changelog/<tag> and opens a pull
+// request into the release branch, e.g. for protected default branches.
+func (repo *GitHubRepository) openChangelogPullRequest(tag, branch, section string) error {
+	if strings.TrimSpace(section) == "" {
+		return nil
+	}
+	base, _, err := repo.client.Git.GetRef(context.Background(), repo.owner, repo.repo, "heads/"+branch)
+	if err != nil {
+		return fmt.Errorf("failed to get branch %s: %w", branch, err)
+	}
+	head := "changelog/" + tag
+	_, _, err = repo.client.Git.CreateRef(context.Background(), repo.owner, repo.repo, &github.Reference{
+		Ref:    github.String("refs/heads/" + head),
+		Object: &github.GitObject{SHA: github.String(base.GetObject().GetSHA())},
+	})
+	if err != nil && !isAlreadyExistsError(err) {
+		return fmt.Errorf("failed to create branch %s: %w", head, err)
+	}
+	title := fmt.Sprintf("docs: update %s for %s", repo.changelogFile, tag)
+	if err := repo.commitChangelog(head, title, section); err != nil {
+		return err
+	}
+	pr, _, err := repo.client.PullRequests.Create(context.Background(), repo.owner, repo.repo, &github.NewPullRequest{
+		Title: github.String(title),
+		Head:  github.String(head),
+		Base:  github.String(branch),
+		Body:  github.String(fmt.Sprintf("Adds the changelog of %s to `%s`.", tag, repo.changelogFile)),
+	})
+	if isAlreadyExistsError(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open changelog pull request: %w", err)
+	}
+	if repo.changelogAutoMerge {
+		// auto-merge has to be allowed in the repository settings
+		if err := repo.graphQL(enableAutoMergeMutation, map[string]interface{}{"pullRequestId": pr.GetNodeID()}, &struct{}{}); err != nil {
+			log.Printf("warning: failed to enable auto-merge of pull request #%d: %v", pr.GetNumber(), err)
+		}
+	}
+	return nil
+}
+
+// commitChangelogToBranch commits the changelog of the release directly to the release branch. The author and
+// committer are left to GitHub, so that the commit is signed if the token belongs to a GitHub App or bot.
+func (repo *GitHubRepository) commitChangelogToBranch(tag, branch, section string) error {
+	if strings.TrimSpace(section) == "" {
+		return nil
+	}
+	// the commit must not trigger another release run
+	return repo.commitChangelog(branch, fmt.Sprintf("docs: update %s for %s [skip ci]", repo.changelogFile, tag), section)
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertChangelogSection(t *testing.T) {
+	section := "## 2.0.0\n\n* feat: new\n"
+	require.Equal(t, section, insertChangelogSection("", section))
+	require.Equal(t, "## 2.0.0\n\n* feat: new\n\n## 1.0.0\n", insertChangelogSection("## 1.0.0\n", section))
+	require.Equal(t, "# Changelog\n\n## 2.0.0\n\n* feat: new\n\n## 1.0.0\n", insertChangelogSection("# Changelog\n\n## 1.0.0\n", section))
+}
+
+func handleChangelogFile(t *testing.T, rec *githubRecorder, branch, content string) *github.RepositoryContentFileOptions {
+	rec.handle("GET /repos/owner/test-repo/contents/CHANGELOG.md", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, branch, r.URL.Query().Get("ref"))
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"type":     "file",
+			"encoding": "base64",
+			"sha":      "blob",
+			"content":  base64.StdEncoding.EncodeToString([]byte(content)),
+		})
+	})
+	update := &github.RepositoryContentFileOptions{}
+	rec.handle("PUT /repos/owner/test-repo/contents/CHANGELOG.md", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(update))
+		fmt.Fprint(w, "{}")
+	})
+	return update
+}
+
+func TestGithubChangelogPullRequest(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_changelog_update":     "pr",
+		"github_changelog_auto_merge": "true",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/git/ref/heads/master", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.Reference{Object: &github.GitObject{SHA: github.String("head")}})
+	})
+	refs := make(map[string]string)
+	rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		var data map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&data))
+		refs[data["ref"]] = data["sha"]
+		fmt.Fprint(w, "{}")
+	})
+	update := handleChangelogFile(t, rec, "changelog/v2.0.0", "# Changelog\n\n## 1.1.1\n")
+	pr := &github.NewPullRequest{}
+	rec.handle("POST /repos/owner/test-repo/pulls", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(pr))
+		fmt.Fprint(w, `{"number":5,"node_id":"PR_5"}`)
+	})
+	var autoMerge graphQLRequest
+	rec.handle("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&autoMerge))
+		fmt.Fprint(w, `{"data":{}}`)
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "master", Changelog: "## 2.0.0\n\n* feat: new\n"})
+	require.NoError(t, err)
+	require.Equal(t, "head", refs["refs/heads/changelog/v2.0.0"])
+	require.Equal(t, "changelog/v2.0.0", update.GetBranch())
+	require.Equal(t, "blob", update.GetSHA())
+	require.Equal(t, "# Changelog\n\n## 2.0.0\n\n* feat: new\n\n## 1.1.1\n", string(update.Content))
+	require.Equal(t, "docs: update CHANGELOG.md for v2.0.0", pr.GetTitle())
+	require.Equal(t, "master", pr.GetBase())
+	require.Equal(t, "changelog/v2.0.0", pr.GetHead())
+	require.Equal(t, "PR_5", autoMerge.Variables["pullRequestId"])
+}
+
+func TestGithubChangelogCommit(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_changelog_update": "commit"})
+	defer ts.Close()
+	update := handleChangelogFile(t, rec, "master", "## 1.1.1\n")
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "master", Changelog: "## 2.0.0\n"})
+	require.NoError(t, err)
+	require.Equal(t, "master", update.GetBranch())
+	require.Equal(t, "docs: update CHANGELOG.md for v2.0.0 [skip ci]", update.GetMessage())
+	require.Equal(t, "## 2.0.0\n\n## 1.1.1\n", string(update.Content))
+	require.Nil(t, update.Committer)
+
+	// the section is only added once
+	update = handleChangelogFile(t, rec, "master", "## 2.0.0\n\n## 1.1.1\n")
+	err = repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "master", Changelog: "## 2.0.0\n"})
+	require.NoError(t, err)
+	require.Nil(t, update.Message)
+}
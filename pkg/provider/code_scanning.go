@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// codeScanningSeverities are the security severities and the rule severities of code scanning alerts.
+var codeScanningSeverities = map[string]bool{
+	"critical": true, "high": true, "medium": true, "low": true,
+	"error": true, "warning": true, "note": true,
+}
+
+func parseCodeScanningGate(value string) (map[string]bool, error) {
+	severities := splitList(value)
+	if len(severities) == 0 {
+		return nil, nil
+	}
+	gate := make(map[string]bool)
+	for _, severity := range severities {
+		if !codeScanningSeverities[severity] {
+			return nil, fmt.Errorf("invalid value for github_code_scanning_gate: %s", severity)
+		}
+		gate[severity] = true
+	}
+	return gate, nil
+}
+
+// countCodeScanningAlerts returns the number of open code scanning alerts of ref whose security severity or rule
+// severity is gated.
+func (repo *GitHubRepository) countCodeScanningAlerts(ref string) (int, error) {
+	count := 0
+	opts := &github.AlertListOptions{State: "open", Ref: ref, ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		alerts, resp, err := repo.client.CodeScanning.ListAlertsForRepo(context.Background(), repo.owner, repo.repo, opts)
+		if err != nil {
+			return 0, err
+		}
+		for _, alert := range alerts {
+			if repo.codeScanningGate[alert.GetRule().GetSecuritySeverityLevel()] || repo.codeScanningGate[alert.GetRule().GetSeverity()] {
+				count++
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.ListOptions.Page = resp.NextPage
+	}
+	return count, nil
+}
+
+// checkCodeScanningAlerts blocks the release if the release branch has open code scanning alerts of the gated
+// severities, unless the gate is overridden.
+func (repo *GitHubRepository) checkCodeScanningAlerts(branch string) error {
+	// without ref the alerts of the default branch are listed
+	ref, target := "", "the default branch"
+	if branch != "" {
+		ref, target = "refs/heads/"+branch, branch
+	}
+	count, err := repo.countCodeScanningAlerts(ref)
+	if err != nil {
+		return fmt.Errorf("failed to list code scanning alerts: %w", err)
+	}
+	if count == 0 {
+		return nil
+	}
+	if repo.codeScanningOverride {
+		log.Printf("warning: ignoring %d open code scanning alert(s) because github_code_scanning_override is set", count)
+		return nil
+	}
+	return fmt.Errorf("%d open code scanning alert(s) on %s, the release is blocked (set github_code_scanning_override=true to release anyway)", count, target)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubCodeScanningGate(t *testing.T) {
+	for _, override := range []string{"false", "true"} {
+		t.Run(override, func(t *testing.T) {
+			repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+				"github_code_scanning_gate":     "critical,error",
+				"github_code_scanning_override": override,
+			})
+			defer ts.Close()
+			rec.handle("GET /repos/owner/test-repo/code-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "open", r.URL.Query().Get("state"))
+				require.Equal(t, "refs/heads/main", r.URL.Query().Get("ref"))
+				fmt.Fprint(w, `[
+					{"number":1,"rule":{"severity":"warning","security_severity_level":"critical"}},
+					{"number":2,"rule":{"severity":"error"}},
+					{"number":3,"rule":{"severity":"warning","security_severity_level":"medium"}}
+				]`)
+			})
+
+			err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "main"})
+			if override == "true" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, "2 open code scanning alert(s) on main, the release is blocked (set github_code_scanning_override=true to release anyway)")
+			require.Nil(t, rec.lastRelease())
+		})
+	}
+}
+
+func TestParseCodeScanningGate(t *testing.T) {
+	_, err := parseCodeScanningGate("high,severe")
+	require.EqualError(t, err, "invalid value for github_code_scanning_gate: severe")
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+var codenameAdjectives = []string{
+	"Amber", "Azure", "Bold", "Brave", "Bright", "Calm", "Clever", "Cobalt", "Copper", "Crimson",
+	"Daring", "Dusty", "Eager", "Emerald", "Fierce", "Frosty", "Gentle", "Golden", "Hidden", "Humble",
+	"Indigo", "Ivory", "Jade", "Jolly", "Keen", "Lively", "Lucky", "Lunar", "Mellow", "Misty",
+	"Nimble", "Noble", "Ochre", "Polar", "Proud", "Quiet", "Rapid", "Rustic", "Sable", "Scarlet",
+	"Silent", "Silver", "Solar", "Steady", "Swift", "Tawny", "Velvet", "Vivid", "Wild", "Wise",
+}
+
+var codenameAnimals = []string{
+	"Albatross", "Badger", "Bison", "Buffalo", "Caribou", "Cheetah", "Condor", "Cougar", "Coyote", "Crane",
+	"Dolphin", "Eagle", "Falcon", "Ferret", "Finch", "Fox", "Gazelle", "Gecko", "Heron", "Ibex",
+	"Jackal", "Jaguar", "Kestrel", "Koala", "Lemur", "Lynx", "Marten", "Meerkat", "Moose", "Narwhal",
+	"Ocelot", "Orca", "Osprey", "Otter", "Owl", "Panther", "Pelican", "Puffin", "Raven", "Salmon",
+	"Seal", "Sparrow", "Stork", "Tiger", "Toucan", "Walrus", "Weasel", "Wolf", "Wombat", "Yak",
+}
+
+// codename returns a name like "Crimson Falcon" that is derived from the version without its prerelease and build
+// metadata, so that all prereleases of a version share the codename of the final release.
+func codename(version string) string {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return ""
+	}
+	h := fnv.New32a()
+	_, _ = fmt.Fprintf(h, "%d.%d.%d", v.Major(), v.Minor(), v.Patch())
+	sum := h.Sum32()
+	adjective := codenameAdjectives[sum%uint32(len(codenameAdjectives))]
+	animal := codenameAnimals[(sum/uint32(len(codenameAdjectives)))%uint32(len(codenameAnimals))]
+	return adjective + " " + animal
+}
+
+func parseReleaseName(value string) (*template.Template, error) {
+	if value == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New("github_release_name").Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse github_release_name: %w", err)
+	}
+	return tmpl, nil
+}
+
+// releaseName renders the title of the release, which defaults to the tag.
+func (repo *GitHubRepository) releaseName(data releaseNameData) (string, error) {
+	if repo.releaseNameTemplate == nil {
+		return data.Tag, nil
+	}
+	var name strings.Builder
+	if err := repo.releaseNameTemplate.Execute(&name, data); err != nil {
+		return "", fmt.Errorf("failed to render github_release_name: %w", err)
+	}
+	return strings.TrimSpace(name.String()), nil
+}
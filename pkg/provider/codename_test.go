@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodename(t *testing.T) {
+	name := codename("2.3.0")
+	require.Regexp(t, `^[A-Z][a-z]+ [A-Z][a-z]+$`, name)
+	require.Equal(t, name, codename("2.3.0"))
+	require.Equal(t, name, codename("2.3.0-beta.1+build.5"))
+	require.NotEqual(t, name, codename("2.3.1"))
+	require.Equal(t, "", codename("invalid"))
+}
+
+func TestGithubReleaseName(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_release_name": "{{.Tag}} — {{.Codename}}",
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, "v2.0.0 — "+codename("2.0.0"), rec.lastRelease().GetName())
+}
+
+func TestGithubInvalidReleaseName(t *testing.T) {
+	repo := &GitHubRepository{}
+	err := repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_release_name": "{{.Tag"})
+	require.ErrorContains(t, err, "failed to parse github_release_name")
+}
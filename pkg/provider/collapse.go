@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	headingRe   = regexp.MustCompile(`^#{1,6}\s`)
+	listEntryRe = regexp.MustCompile(`^[*-]\s`)
+)
+
+func isCodeFence(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+}
+
+// collapseSection wraps the content of a section in a <details> block if it has more than threshold top level list
+// entries. Leading and trailing empty lines are kept outside of the block.
+func collapseSection(lines []string, threshold int) []string {
+	entries := 0
+	inFence := false
+	for _, line := range lines {
+		if isCodeFence(line) {
+			inFence = !inFence
+		}
+		if !inFence && listEntryRe.MatchString(line) {
+			entries++
+		}
+	}
+	if entries <= threshold {
+		return lines
+	}
+	start, end := 0, len(lines)
+	for start < end && strings.TrimSpace(lines[start]) == "" {
+		start++
+	}
+	for end > start && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+	collapsed := make([]string, 0, len(lines)+6)
+	collapsed = append(collapsed, lines[:start]...)
+	if start == 0 {
+		collapsed = append(collapsed, "")
+	}
+	collapsed = append(collapsed, "<details>", fmt.Sprintf("<summary>%d changes</summary>", entries), "")
+	collapsed = append(collapsed, lines[start:end]...)
+	collapsed = append(collapsed, "", "</details>")
+	return append(collapsed, lines[end:]...)
+}
+
+// collapseSections collapses all sections of the markdown body (the content between two headings) with more than
+// threshold list entries, so that large releases stay readable. Headings in code blocks are ignored.
+func collapseSections(body string, threshold int) string {
+	lines := strings.Split(body, "\n")
+	out := make([]string, 0, len(lines))
+	inFence := false
+	sectionStart := -1
+	for i, line := range lines {
+		if isCodeFence(line) {
+			inFence = !inFence
+		}
+		if inFence || !headingRe.MatchString(line) {
+			continue
+		}
+		if sectionStart >= 0 {
+			out = append(out, collapseSection(lines[sectionStart:i], threshold)...)
+		} else {
+			out = append(out, lines[:i]...)
+		}
+		out = append(out, line)
+		sectionStart = i + 1
+	}
+	if sectionStart < 0 {
+		return body
+	}
+	out = append(out, collapseSection(lines[sectionStart:], threshold)...)
+	return strings.Join(out, "\n")
+}
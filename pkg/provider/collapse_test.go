@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollapseSections(t *testing.T) {
+	body := "intro\n* a\n* b\n* c\n\n#### Feature\n\n* a\n* b\n  * nested\n* c\n\n#### Bug Fixes\n\n* a\n```\n# not a heading\n* b\n* c\n```\n"
+	expected := "intro\n* a\n* b\n* c\n\n#### Feature\n\n<details>\n<summary>3 changes</summary>\n\n* a\n* b\n  * nested\n* c\n\n</details>\n\n" +
+		"#### Bug Fixes\n\n* a\n```\n# not a heading\n* b\n* c\n```\n"
+	require.Equal(t, expected, collapseSections(body, 2))
+	require.Equal(t, body, collapseSections(body, 3))
+	require.Equal(t, "* a\n* b\n* c", collapseSections("* a\n* b\n* c", 1))
+	require.Equal(t, "## Heading\n\n<details>\n<summary>2 changes</summary>\n\n* a\n* b\n\n</details>", collapseSections("## Heading\n* a\n* b", 1))
+}
+
+func TestGithubCollapseThreshold(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_collapse_threshold": "1",
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "## 2.0.0\n\n* a\n* b\n"})
+	require.NoError(t, err)
+	require.Equal(t, "## 2.0.0\n\n<details>\n<summary>2 changes</summary>\n\n* a\n* b\n\n</details>\n", rec.lastRelease().GetBody())
+
+	err = (&GitHubRepository{}).Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_collapse_threshold": "0"})
+	require.EqualError(t, err, "invalid value for github_collapse_threshold: 0")
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/semrel"
+	"github.com/google/go-github/v66/github"
+)
+
+// listCommitFiles returns the paths of the files changed by the commit, renamed files are listed with both paths.
+func (repo *GitHubRepository) listCommitFiles(sha string) ([]string, error) {
+	files := make([]string, 0)
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		commit, resp, err := repo.client.Repositories.GetCommit(context.Background(), repo.owner, repo.repo, sha, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get files of commit %s: %w", sha, err)
+		}
+		for _, file := range commit.Files {
+			if previous := file.GetPreviousFilename(); previous != "" {
+				files = append(files, previous)
+			}
+			files = append(files, file.GetFilename())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return files, nil
+}
+
+// annotateFiles adds the changed files as newline separated list to the files annotation.
+func annotateFiles(commit *semrel.RawCommit, files []string) {
+	commit.Annotations["files"] = strings.Join(files, "\n")
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func handleCommitFiles(rec *githubRecorder, files map[string]string) {
+	for sha, commitFiles := range files {
+		rec.handle("GET /repos/owner/test-repo/commits/"+sha, func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprintf(w, `{"sha": %q, "files": %s}`, sha, commitFiles)
+		})
+	}
+}
+
+var testCommitFiles = map[string]string{
+	"1111": `[{"filename": "api/main.go"}, {"filename": "api/README.md", "previous_filename": "README.md"}]`,
+	"abcd": `[{"filename": "web/index.html"}]`,
+	"dcba": `[{"filename": "api/fix.go"}]`,
+	"cdba": `[{"filename": "docs/index.md"}]`,
+	"efcd": `[]`,
+}
+
+func TestGithubFileAnnotations(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_file_annotations": "true",
+	})
+	defer ts.Close()
+	handleCommitFiles(rec, testCommitFiles)
+
+	commits, err := repo.GetCommits("2222", "1111")
+	require.NoError(t, err)
+	require.Len(t, commits, 5)
+	require.Equal(t, "api/main.go\nREADME.md\napi/README.md", commits[0].Annotations["files"])
+	require.Equal(t, "web/index.html", commits[1].Annotations["files"])
+	require.Equal(t, "", commits[4].Annotations["files"])
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// divergedCommitsLimit bounds the commits that are listed if the previous release is not an ancestor of the head.
+const divergedCommitsLimit = 250
+
+// parseCommitsSince parses the github_commits_since option, either a RFC 3339 timestamp or a date.
+func parseCommitsSince(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if since, err := time.Parse(layout, value); err == nil {
+			return since, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid value for github_commits_since: %s", value)
+}
+
+// isAncestor reports whether fromSha is an ancestor of (or identical to) toSha. A fromSha that does not exist anymore,
+// e.g. after a force push, is not an ancestor.
+func (repo *GitHubRepository) isAncestor(fromSha, toSha string) (bool, error) {
+	comparison, resp, err := repo.client.Repositories.CompareCommits(context.Background(), repo.owner, repo.repo, fromSha, toSha, &github.ListOptions{PerPage: 1})
+	if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusUnprocessableEntity) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to compare %s...%s: %w", fromSha, toSha, err)
+	}
+	switch comparison.GetStatus() {
+	case "diverged", "behind":
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
+// isReachableFrom reports whether sha is reachable from branch, the results are cached by sha.
+func (repo *GitHubRepository) isReachableFrom(sha, branch string, cache map[string]bool) (bool, error) {
+	if reachable, ok := cache[sha]; ok {
+		return reachable, nil
+	}
+	reachable, err := repo.isAncestor(sha, branch)
+	if err != nil {
+		return false, err
+	}
+	cache[sha] = reachable
+	return reachable, nil
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommitsSince(t *testing.T) {
+	since, err := parseCommitsSince("")
+	require.NoError(t, err)
+	require.True(t, since.IsZero())
+
+	since, err = parseCommitsSince("2024-01-02")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), since)
+
+	since, err = parseCommitsSince("2024-01-02T03:04:05Z")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), since)
+
+	_, err = parseCommitsSince("last year")
+	require.ErrorContains(t, err, "invalid value for github_commits_since")
+}
+
+func TestGithubGetCommitsRangeLimits(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_max_commits":   "3",
+		"github_commits_since": "2024-01-02",
+	})
+	defer ts.Close()
+	since := ""
+	rec.handle("GET /repos/owner/test-repo/commits", func(w http.ResponseWriter, r *http.Request) {
+		since = r.URL.Query().Get("since")
+		_ = json.NewEncoder(w).Encode(githubCommits)
+	})
+
+	commits, err := repo.GetCommits("", "abcd")
+	require.NoError(t, err)
+	require.Len(t, commits, 3)
+	require.Equal(t, "2024-01-02T00:00:00Z", since)
+}
+
+func TestGithubGetCommitsInvalidMaxCommits(t *testing.T) {
+	repo := &GitHubRepository{}
+	err := repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_max_commits": "-1"})
+	require.ErrorContains(t, err, "invalid value for github_max_commits")
+}
+
+func TestGithubGetCommitsCompareFallback(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_use_compare_commits": "true",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/compare/2222...1111", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.CommitsComparison{
+			TotalCommits: github.Int(300),
+			Commits:      githubCommits[4:6],
+		})
+	})
+
+	commits, err := repo.GetCommits("2222", "1111")
+	require.NoError(t, err)
+	require.Len(t, commits, 5)
+	require.Equal(t, "1111", commits[0].SHA)
+}
+
+func TestGithubGetCommitsNotAncestor(t *testing.T) {
+	testCases := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{"diverged", func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"status": "diverged"}`)
+		}},
+		{"missing", func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "not found", http.StatusNotFound)
+		}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+			defer ts.Close()
+			rec.handle("GET /repos/owner/test-repo/compare/2222...1111", tc.handler)
+
+			commits, err := repo.GetCommits("2222", "1111")
+			require.NoError(t, err)
+			// the history is listed without stopping at 2222
+			require.Len(t, commits, 7)
+		})
+	}
+}
+
+func TestGithubGetReleasesFromBranch(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_releases_branch": "2.x",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/git/matching-refs/tags", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[
+			{"ref": "refs/tags/v2.0.0", "object": {"type": "commit", "sha": "a2"}},
+			{"ref": "refs/tags/v2.1.0", "object": {"type": "commit", "sha": "b2"}},
+			{"ref": "refs/tags/v3.0.0", "object": {"type": "commit", "sha": "c3"}}
+		]`)
+	})
+	compared := 0
+	for sha, status := range map[string]string{"a2": "ahead", "b2": "identical", "c3": "diverged"} {
+		rec.handle("GET /repos/owner/test-repo/compare/"+sha+"...2.x", func(w http.ResponseWriter, _ *http.Request) {
+			compared++
+			fmt.Fprintf(w, `{"status": %q}`, status)
+		})
+	}
+
+	for i := 0; i < 2; i++ {
+		releases, err := repo.GetReleases("")
+		require.NoError(t, err)
+		require.Len(t, releases, 2)
+		require.Equal(t, "2.0.0", releases[0].Version)
+		require.Equal(t, "2.1.0", releases[1].Version)
+	}
+	require.Equal(t, 6, compared)
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v66/github"
+)
+
+const defaultCommitStatusContext = "semantic-release/published"
+
+// createCommitStatus sets a successful commit status linking to the release on the released SHA.
+func (repo *GitHubRepository) createCommitStatus(sha, tag, releaseURL string) error {
+	status := &github.RepoStatus{
+		State:       github.String("success"),
+		Context:     github.String(repo.commitStatusContext),
+		Description: github.String(fmt.Sprintf("Released %s", tag)),
+	}
+	if releaseURL != "" {
+		status.TargetURL = github.String(releaseURL)
+	}
+	_, _, err := repo.client.Repositories.CreateStatus(context.Background(), repo.owner, repo.repo, sha, status)
+	if err != nil {
+		return fmt.Errorf("failed to create commit status: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubCommitStatus(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_commit_status": "true",
+	})
+	defer ts.Close()
+	releaseURL := "https://github.com/owner/test-repo/releases/tag/v2.0.0"
+	rec.handle("POST /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.RepositoryRelease{ID: github.Int64(1), HTMLURL: &releaseURL})
+	})
+	var status *github.RepoStatus
+	rec.handle("POST /repos/owner/test-repo/statuses/"+testSHA, func(w http.ResponseWriter, r *http.Request) {
+		status = &github.RepoStatus{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(status))
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.NotNil(t, status)
+	require.Equal(t, "success", status.GetState())
+	require.Equal(t, "semantic-release/published", status.GetContext())
+	require.Equal(t, "Released v2.0.0", status.GetDescription())
+	require.Equal(t, releaseURL, status.GetTargetURL())
+}
+
+func TestGithubCommitStatusContext(t *testing.T) {
+	repo := &GitHubRepository{}
+	err := repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_commit_status_context": "release"})
+	require.NoError(t, err)
+	require.Empty(t, repo.commitStatusContext)
+
+	err = repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_commit_status": "true", "github_commit_status_context": "release"})
+	require.NoError(t, err)
+	require.Equal(t, "release", repo.commitStatusContext)
+}
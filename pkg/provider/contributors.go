@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// contributor is an author of commits or pull requests of a release, firstContribution is the URL of the first pull
+// request (or commit if there is none) of the author in the release.
+type contributor struct {
+	login             string
+	firstContribution string
+}
+
+func (repo *GitHubRepository) isIgnoredLogin(login string) bool {
+	for _, pattern := range repo.ignoreAuthors {
+		if pattern.MatchString(login) {
+			return true
+		}
+	}
+	return false
+}
+
+// listContributors returns the authors of the commits between previousTag and sha and of their merged pull requests in
+// order of their first contribution. Authors without a GitHub account and ignored authors are skipped.
+func (repo *GitHubRepository) listContributors(previousTag, sha string) ([]*contributor, error) {
+	commits, err := repo.listCommitsBetween(previousTag, sha)
+	if err != nil {
+		return nil, err
+	}
+	// the comparison lists the oldest commit first, the commit list without a previous tag the newest
+	if previousTag == "" {
+		for i, j := 0, len(commits)-1; i < j; i, j = i+1, j-1 {
+			commits[i], commits[j] = commits[j], commits[i]
+		}
+	}
+	contributors := make([]*contributor, 0)
+	seen := make(map[string]bool)
+	add := func(login, url string) {
+		if login == "" || seen[strings.ToLower(login)] || repo.isIgnoredLogin(login) {
+			return
+		}
+		seen[strings.ToLower(login)] = true
+		contributors = append(contributors, &contributor{login: login, firstContribution: url})
+	}
+	for _, commit := range commits {
+		pr, err := repo.findCommitPullRequest(commit.GetSHA())
+		if err != nil {
+			return nil, err
+		}
+		if pr != nil {
+			add(pr.GetUser().GetLogin(), pr.GetHTMLURL())
+			add(commit.GetAuthor().GetLogin(), pr.GetHTMLURL())
+			continue
+		}
+		add(commit.GetAuthor().GetLogin(), repo.commitURL(commit))
+	}
+	return contributors, nil
+}
+
+// isFirstContribution reports whether login has not authored any commit reachable from previousTag.
+func (repo *GitHubRepository) isFirstContribution(login, previousTag string) (bool, error) {
+	commits, _, err := repo.client.Repositories.ListCommits(context.Background(), repo.owner, repo.repo, &github.CommitsListOptions{
+		SHA:         previousTag,
+		Author:      login,
+		ListOptions: github.ListOptions{PerPage: 1},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(commits) == 0, nil
+}
+
+// contributorsSection renders the "Contributors" section and, if enabled, the "New Contributors" section like
+// GitHub's generated release notes. New contributors are only determined if there is a previous tag.
+func (repo *GitHubRepository) contributorsSection(previousTag, sha string) (string, error) {
+	contributors, err := repo.listContributors(previousTag, sha)
+	if err != nil {
+		return "", err
+	}
+	if len(contributors) == 0 {
+		return "", nil
+	}
+	logins := make([]string, 0, len(contributors))
+	for _, c := range contributors {
+		logins = append(logins, "@"+c.login)
+	}
+	section := "## Contributors\n" + strings.Join(logins, ", ") + "\n"
+	if !repo.newContributors || previousTag == "" {
+		return section, nil
+	}
+
+	sb := &strings.Builder{}
+	for _, c := range contributors {
+		first, err := repo.isFirstContribution(c.login, previousTag)
+		if err != nil {
+			return "", fmt.Errorf("failed to list commits of %s: %w", c.login, err)
+		}
+		if first {
+			fmt.Fprintf(sb, "* @%s made their first contribution in %s\n", c.login, c.firstContribution)
+		}
+	}
+	if sb.Len() == 0 {
+		return section, nil
+	}
+	return section + "\n## New Contributors\n" + sb.String(), nil
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func handleContributors(rec *githubRecorder) {
+	bobCommit := createGithubCommit("1111", "fix: bug")
+	bobCommit.Author = &github.User{Login: github.String("bob")}
+	bobCommit.HTMLURL = github.String("https://github.com/owner/test-repo/commit/1111")
+	botCommit := createGithubCommit("2222", "chore: deps")
+	botCommit.Author = &github.User{Login: github.String("dependabot[bot]")}
+	rec.handle("GET /repos/owner/test-repo/compare/v1.1.1...deadbeef", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.CommitsComparison{Commits: []*github.RepositoryCommit{githubCommits[0], bobCommit, botCommit}})
+	})
+	rec.handle("GET /repos/owner/test-repo/commits/abcd/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]*github.PullRequest{createGithubPullRequest(1, "feat", "alice")})
+	})
+	for _, sha := range []string{"1111", "2222"} {
+		rec.handle("GET /repos/owner/test-repo/commits/"+sha+"/pulls", func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode([]*github.PullRequest{})
+		})
+	}
+	rec.handle("GET /repos/owner/test-repo/commits", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("sha") != "v1.1.1" || r.URL.Query().Get("author") == "bob" {
+			_ = json.NewEncoder(w).Encode([]*github.RepositoryCommit{})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(githubCommits[:1])
+	})
+}
+
+func TestGithubContributors(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_contributors": "true",
+		"ignore_authors":      "*[bot]",
+	})
+	defer ts.Close()
+	handleContributors(rec)
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog"})
+	require.NoError(t, err)
+	require.Equal(t, "changelog\n\n## Contributors\n@alice, @"+githubAuthorLogin+", @bob\n", rec.lastRelease().GetBody())
+}
+
+func TestGithubNewContributors(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_new_contributors": "true",
+		"ignore_authors":          "*[bot]",
+	})
+	defer ts.Close()
+	handleContributors(rec)
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog"})
+	require.NoError(t, err)
+	expected := "changelog\n\n## Contributors\n@alice, @" + githubAuthorLogin + ", @bob\n\n" +
+		"## New Contributors\n* @bob made their first contribution in https://github.com/owner/test-repo/commit/1111\n"
+	require.Equal(t, expected, rec.lastRelease().GetBody())
+}
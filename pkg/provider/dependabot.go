@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// countDependabotAlerts returns the number of open Dependabot alerts with one of the gated severities.
+func (repo *GitHubRepository) countDependabotAlerts() (int, error) {
+	count := 0
+	opts := &github.ListAlertsOptions{
+		State:       github.String("open"),
+		Severity:    github.String(strings.Join(repo.dependabotGate, ",")),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		alerts, resp, err := repo.client.Dependabot.ListRepoAlerts(context.Background(), repo.owner, repo.repo, opts)
+		if err != nil {
+			return 0, err
+		}
+		count += len(alerts)
+		// the alerts are paginated with cursors
+		if resp.After == "" {
+			break
+		}
+		opts.ListCursorOptions.After = resp.After
+	}
+	return count, nil
+}
+
+// checkDependabotAlerts applies the configured action if open Dependabot alerts at or above the severity threshold
+// exist.
+func (repo *GitHubRepository) checkDependabotAlerts(prerelease, draft *bool) error {
+	count, err := repo.countDependabotAlerts()
+	if err != nil {
+		return fmt.Errorf("failed to list Dependabot alerts: %w", err)
+	}
+	if count == 0 {
+		return nil
+	}
+	reason := fmt.Sprintf("%d open Dependabot alert(s) with %s severity", count, severitiesDescription(repo.dependabotGate))
+	return applyGate(repo.dependabotGateAction, reason, prerelease, draft)
+}
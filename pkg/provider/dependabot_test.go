@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSeverityThreshold(t *testing.T) {
+	severities, err := parseSeverityThreshold("github_dependabot_gate", "high")
+	require.NoError(t, err)
+	require.Equal(t, []string{"high", "critical"}, severities)
+	require.Equal(t, "high or critical", severitiesDescription(severities))
+	_, err = parseSeverityThreshold("github_dependabot_gate", "severe")
+	require.EqualError(t, err, "invalid value for github_dependabot_gate: severe")
+}
+
+func TestGithubDependabotGate(t *testing.T) {
+	testCases := []struct {
+		action     string
+		err        string
+		prerelease bool
+		draft      bool
+	}{
+		{"", "2 open Dependabot alert(s) with medium, high or critical severity, the release is blocked", false, false},
+		{"prerelease", "", true, false},
+		{"draft", "", false, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.action, func(t *testing.T) {
+			repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+				"github_dependabot_gate":        "medium",
+				"github_dependabot_gate_action": tc.action,
+			})
+			defer ts.Close()
+			rec.handle("GET /repos/owner/test-repo/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "open", r.URL.Query().Get("state"))
+				require.Equal(t, "medium,high,critical", r.URL.Query().Get("severity"))
+				if r.URL.Query().Get("after") == "" {
+					w.Header().Set("Link", `<https://api.github.com/repos/owner/test-repo/dependabot/alerts?after=abc>; rel="next"`)
+					fmt.Fprint(w, `[{"number":1}]`)
+					return
+				}
+				fmt.Fprint(w, `[{"number":2}]`)
+			})
+
+			err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				require.Nil(t, rec.lastRelease())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.prerelease, rec.lastRelease().GetPrerelease())
+			require.Equal(t, tc.draft, rec.lastRelease().GetDraft())
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// createDeployment records the release as successful deployment of the tag to the configured environment.
+func (repo *GitHubRepository) createDeployment(tag, releaseURL string) error {
+	deployment, _, err := repo.client.Repositories.CreateDeployment(context.Background(), repo.owner, repo.repo, &github.DeploymentRequest{
+		Ref:         github.String(tag),
+		Environment: github.String(repo.deploymentEnvironment),
+		Description: github.String(fmt.Sprintf("Release %s", tag)),
+		AutoMerge:   github.Bool(false),
+		// the commit statuses of the released SHA have already been checked before releasing
+		RequiredContexts: &[]string{},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create deployment: %w", err)
+	}
+	status := &github.DeploymentStatusRequest{
+		State:       github.String("success"),
+		Description: github.String(fmt.Sprintf("Released %s", tag)),
+	}
+	if releaseURL != "" {
+		status.LogURL = github.String(releaseURL)
+	}
+	_, _, err = repo.client.Repositories.CreateDeploymentStatus(context.Background(), repo.owner, repo.repo, deployment.GetID(), status)
+	if err != nil {
+		return fmt.Errorf("failed to create deployment status: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubDeployment(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_deployment_environment": "production",
+	})
+	defer ts.Close()
+	releaseURL := "https://github.com/owner/test-repo/releases/tag/v2.0.0"
+	rec.handle("POST /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.RepositoryRelease{ID: github.Int64(1), HTMLURL: &releaseURL})
+	})
+	var deployment map[string]interface{}
+	rec.handle("POST /repos/owner/test-repo/deployments", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&deployment))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":42}`)
+	})
+	var status *github.DeploymentStatusRequest
+	rec.handle("POST /repos/owner/test-repo/deployments/42/statuses", func(w http.ResponseWriter, r *http.Request) {
+		status = &github.DeploymentStatusRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(status))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, "v2.0.0", deployment["ref"])
+	require.Equal(t, "production", deployment["environment"])
+	require.Equal(t, []interface{}{}, deployment["required_contexts"])
+	require.NotNil(t, status)
+	require.Equal(t, "success", status.GetState())
+	require.Equal(t, releaseURL, status.GetLogURL())
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+)
+
+const (
+	displayNamesAppend  = "append"
+	displayNamesReplace = "replace"
+)
+
+// displayName returns the name of the user's profile or an empty string if the user has none or does not exist, the
+// names are cached for the lifetime of the provider.
+func (repo *GitHubRepository) displayName(login string) string {
+	key := strings.ToLower(login)
+	if name, ok := repo.displayNameCache[key]; ok {
+		return name
+	}
+	user, resp, err := repo.client.Users.Get(context.Background(), login)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		log.Printf("warning: failed to get user %s: %v", login, err)
+		return ""
+	}
+	name := ""
+	if err == nil && !strings.EqualFold(user.GetName(), login) {
+		name = user.GetName()
+	}
+	repo.displayNameCache[key] = name
+	return name
+}
+
+// resolveDisplayNames appends the display name to user mentions (@login (Jane Doe)) or replaces the mentions with a
+// link to the profile named after the user ([Jane Doe](https://github.com/login)). Team mentions, mentions in code and
+// links as well as users without a display name are left untouched.
+func (repo *GitHubRepository) resolveDisplayNames(body string) string {
+	sb := &strings.Builder{}
+	last := 0
+	for _, m := range mentionRe.FindAllStringSubmatchIndex(body, -1) {
+		sb.WriteString(body[last:m[0]])
+		last = m[1]
+		if m[2] >= 0 || strings.Contains(body[m[6]:m[7]], "/") {
+			sb.WriteString(body[m[0]:m[1]])
+			continue
+		}
+		prefix, login := body[m[4]:m[5]], body[m[6]:m[7]]
+		name := repo.displayName(login)
+		switch {
+		case name == "":
+			sb.WriteString(body[m[0]:m[1]])
+		case repo.displayNames == displayNamesReplace:
+			fmt.Fprintf(sb, "%s[%s](https://%s/%s)", prefix, name, repo.webHost(), login)
+		case strings.HasPrefix(body[m[1]:], " ("+name+")"):
+			// already resolved, e.g. in the body of a promoted prerelease
+			sb.WriteString(body[m[0]:m[1]])
+		default:
+			fmt.Fprintf(sb, "%s@%s (%s)", prefix, login, name)
+		}
+	}
+	sb.WriteString(body[last:])
+	return sb.String()
+}
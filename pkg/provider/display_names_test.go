@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func handleUsers(rec *githubRecorder) {
+	rec.handle("GET /users/alice", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.User{Login: github.String("alice"), Name: github.String("Alice Doe")})
+	})
+	rec.handle("GET /users/bob", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.User{Login: github.String("bob")})
+	})
+	rec.handle("GET /users/ghost", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+}
+
+func TestGithubResolveDisplayNames(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_display_names": "append"})
+	defer ts.Close()
+	handleUsers(rec)
+
+	body := "* feat by @alice and @bob, cc @ghost @org/team `@alice`\n* fix by @alice (Alice Doe)"
+	expected := "* feat by @alice (Alice Doe) and @bob, cc @ghost @org/team `@alice`\n* fix by @alice (Alice Doe)"
+	require.Equal(t, expected, repo.resolveDisplayNames(body))
+
+	repo.displayNames = displayNamesReplace
+	require.Equal(t, "by [Alice Doe](https://"+repo.webHost()+"/alice) and @bob", repo.resolveDisplayNames("by @alice and @bob"))
+}
+
+func TestGithubDisplayNamesRelease(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_display_names": "append",
+		"github_mentions":      "escape",
+	})
+	defer ts.Close()
+	handleUsers(rec)
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "* feat by @alice"})
+	require.NoError(t, err)
+	require.Equal(t, "* feat by `@alice` (Alice Doe)", rec.lastRelease().GetBody())
+}
+
+func TestGithubInvalidDisplayNames(t *testing.T) {
+	repo := &GitHubRepository{}
+	err := repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_display_names": "invalid"})
+	require.EqualError(t, err, "invalid value for github_display_names: invalid")
+}
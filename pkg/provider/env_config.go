@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"os"
+	"strings"
+)
+
+const envConfigPrefix = "SEMREL_GITHUB_"
+
+// unprefixedOptions are the provider options without the github_ prefix.
+var unprefixedOptions = map[string]bool{
+	"changelog_footer":     true,
+	"changelog_header":     true,
+	"gpg_passphrase":       true,
+	"gpg_private_key":      true,
+	"ignore_authors":       true,
+	"minisign_password":    true,
+	"minisign_private_key": true,
+	"package":              true,
+	"packages":             true,
+	"paths":                true,
+	"paths_ignore":         true,
+	"slug":                 true,
+	"strip_v_tag_prefix":   true,
+	"tag_exclude":          true,
+	"tag_format":           true,
+	"tag_prefix":           true,
+	"tag_version_parsing":  true,
+	"token":                true,
+}
+
+// envOptionName maps an environment variable to its provider option, e.g. SEMREL_GITHUB_RELEASE_DRAFT to
+// github_release_draft and SEMREL_GITHUB_TAG_FORMAT to tag_format.
+func envOptionName(name string) (string, bool) {
+	if !strings.HasPrefix(name, envConfigPrefix) || len(name) == len(envConfigPrefix) {
+		return "", false
+	}
+	option := strings.ToLower(strings.TrimPrefix(name, envConfigPrefix))
+	if unprefixedOptions[option] {
+		return option, true
+	}
+	return "github_" + option, true
+}
+
+// withEnvConfig returns a copy of config with the options that are only set as SEMREL_GITHUB_* environment
+// variables, options passed by the host take precedence.
+func withEnvConfig(config map[string]string) map[string]string {
+	merged := make(map[string]string, len(config))
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+		if option, ok := envOptionName(name); ok {
+			merged[option] = value
+		}
+	}
+	for k, v := range config {
+		if v != "" {
+			merged[k] = v
+		}
+	}
+	return merged
+}
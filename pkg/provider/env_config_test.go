@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvOptionName(t *testing.T) {
+	testCases := map[string]string{
+		"SEMREL_GITHUB_RELEASE_DRAFT": "github_release_draft",
+		"SEMREL_GITHUB_TAG_FORMAT":    "tag_format",
+		"SEMREL_GITHUB_TOKEN":         "token",
+	}
+	for name, option := range testCases {
+		actual, ok := envOptionName(name)
+		require.True(t, ok)
+		require.Equal(t, option, actual)
+	}
+	for _, name := range []string{"SEMREL_GITHUB_", "GITHUB_TOKEN"} {
+		_, ok := envOptionName(name)
+		require.False(t, ok)
+	}
+}
+
+func TestGithubEnvConfig(t *testing.T) {
+	t.Setenv("SEMREL_GITHUB_RELEASE_DRAFT", "true")
+	t.Setenv("SEMREL_GITHUB_TAG_PREFIX", "api/")
+	t.Setenv("SEMREL_GITHUB_RELEASE_BODY_OVERFLOW", "invalid")
+	repo := &GitHubRepository{}
+	config := map[string]string{"slug": "owner/test-repo", "token": "token", "github_release_body_overflow": "truncate"}
+	require.NoError(t, repo.Init(config))
+	require.True(t, repo.releaseDraft)
+	require.Equal(t, "api/", repo.tagPrefix)
+	require.Equal(t, "truncate", repo.releaseBodyOverflow)
+	// the config of the host is not modified
+	require.Len(t, config, 3)
+}
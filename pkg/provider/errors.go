@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// Sentinel errors that can be matched with errors.Is, the returned errors carry the details of the failure.
+var (
+	ErrTokenMissing = errors.New("github token missing")
+	ErrTagExists    = errors.New("tag already exists")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrRepoArchived = errors.New("repository is archived")
+)
+
+// sentinelError attaches a sentinel error to err without changing its message.
+type sentinelError struct {
+	sentinel error
+	err      error
+}
+
+func (e *sentinelError) Error() string {
+	return e.err.Error()
+}
+
+func (e *sentinelError) Unwrap() []error {
+	return []error{e.sentinel, e.err}
+}
+
+func withSentinel(sentinel, err error) error {
+	return &sentinelError{sentinel: sentinel, err: err}
+}
+
+// apiError adds the request ID and the rate limit state of the failed request to the message of an API error, so
+// that failures can be correlated with GitHub support and throttling is recognizable.
+type apiError struct {
+	err     error
+	context string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s (%s)", e.err.Error(), e.context)
+}
+
+func (e *apiError) Unwrap() error {
+	return e.err
+}
+
+// failedResponse returns the HTTP response of an API error.
+func failedResponse(err error) *http.Response {
+	var rateLimitErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	var errResp *github.ErrorResponse
+	switch {
+	case errors.As(err, &rateLimitErr):
+		return rateLimitErr.Response
+	case errors.As(err, &abuseErr):
+		return abuseErr.Response
+	case errors.As(err, &errResp):
+		return errResp.Response
+	}
+	return nil
+}
+
+func responseContext(resp *http.Response) string {
+	details := make([]string, 0, 2)
+	if requestID := resp.Header.Get("X-GitHub-Request-Id"); requestID != "" {
+		details = append(details, "request ID "+requestID)
+	}
+	if remaining := resp.Header.Get("X-RateLimit-Remaining"); remaining != "" {
+		rateLimit := fmt.Sprintf("rate limit %s/%s remaining", remaining, resp.Header.Get("X-RateLimit-Limit"))
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			rateLimit += ", resets at " + time.Unix(reset, 0).UTC().Format(time.RFC3339)
+		}
+		details = append(details, rateLimit)
+	}
+	return strings.Join(details, ", ")
+}
+
+// classifyError adds the context of the failed request to errors of the GitHub API and attaches ErrRateLimited or
+// ErrUnauthorized.
+func classifyError(err error) error {
+	resp := failedResponse(err)
+	if resp == nil {
+		return err
+	}
+	if details := responseContext(resp); details != "" {
+		err = &apiError{err: err, context: details}
+	}
+	var rateLimitErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &rateLimitErr) || errors.As(err, &abuseErr) {
+		return withSentinel(ErrRateLimited, err)
+	}
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return withSentinel(ErrUnauthorized, err)
+	}
+	return err
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	err := (&GitHubRepository{}).Init(map[string]string{})
+	require.ErrorIs(t, err, ErrTokenMissing)
+
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo", func(w http.ResponseWriter, _ *http.Request) {
+		archivedRepo := githubRepo
+		archivedRepo.Archived = github.Bool(true)
+		_ = json.NewEncoder(w).Encode(archivedRepo)
+	})
+	_, err = repo.GetInfo()
+	require.ErrorIs(t, err, ErrRepoArchived)
+	require.EqualError(t, err, "repository owner/test-repo is archived and can not be released")
+}
+
+func TestClassifyErrors(t *testing.T) {
+	testCases := []struct {
+		status   int
+		header   http.Header
+		sentinel error
+	}{
+		{http.StatusUnauthorized, nil, ErrUnauthorized},
+		{http.StatusForbidden, nil, ErrUnauthorized},
+		{http.StatusForbidden, http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"1700000000"}}, ErrRateLimited},
+		{http.StatusNotFound, nil, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(http.StatusText(tc.status), func(t *testing.T) {
+			repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+			defer ts.Close()
+			rec.handle("GET /repos/owner/test-repo", func(w http.ResponseWriter, _ *http.Request) {
+				for key, values := range tc.header {
+					w.Header()[key] = values
+				}
+				http.Error(w, `{"message":"failed"}`, tc.status)
+			})
+			_, err := repo.GetInfo()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "failed")
+			for _, sentinel := range []error{ErrUnauthorized, ErrRateLimited} {
+				require.Equal(t, sentinel == tc.sentinel, errors.Is(err, sentinel))
+			}
+		})
+	}
+}
+
+func TestErrorRequestContext(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-GitHub-Request-Id", "CAFE:1234")
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		http.Error(w, `{"message":"Server Error"}`, http.StatusInternalServerError)
+	})
+	_, err := repo.GetInfo()
+	require.ErrorContains(t, err, "/repos/owner/test-repo: 500 Server Error []")
+	require.ErrorContains(t, err, "(request ID CAFE:1234, rate limit 4999/5000 remaining, resets at 2023-11-14T22:13:20Z)")
+	var errResp *github.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/google/go-github/v66/github"
+)
+
+const failureIssueLabel = "release-failure"
+
+// actionsRunURL returns the URL of the current GitHub Actions run or an empty string outside of GitHub Actions.
+func actionsRunURL() string {
+	server, repository, runID := os.Getenv("GITHUB_SERVER_URL"), os.Getenv("GITHUB_REPOSITORY"), os.Getenv("GITHUB_RUN_ID")
+	if server == "" || repository == "" || runID == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/actions/runs/%s", server, repository, runID)
+}
+
+func failureReport(tag string, releaseErr error) string {
+	report := fmt.Sprintf("The release of %s failed:\n\n```\n%s\n```", tag, releaseErr)
+	if runURL := actionsRunURL(); runURL != "" {
+		report = appendSection(report, fmt.Sprintf("**Run**: %s", runURL))
+	}
+	return report
+}
+
+// findOpenFailureIssue returns the open failure issue with the given title or nil if there is none.
+func (repo *GitHubRepository) findOpenFailureIssue(title string) (*github.Issue, error) {
+	opts := &github.IssueListByRepoOptions{State: "open", Labels: []string{failureIssueLabel}, ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		issues, resp, err := repo.client.Issues.ListByRepo(context.Background(), repo.owner, repo.repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			if issue.GetTitle() == title && !issue.IsPullRequest() {
+				return issue, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// reportReleaseFailure opens an issue with the error of the failed release, if the release of the tag already failed
+// before the error is added as comment. Errors are only logged so that the original error is returned.
+func (repo *GitHubRepository) reportReleaseFailure(tag string, releaseErr error) {
+	title := fmt.Sprintf("Release %s failed", tag)
+	report := failureReport(tag, releaseErr)
+	issue, err := repo.findOpenFailureIssue(title)
+	if err != nil {
+		log.Printf("warning: failed to list release failure issues: %v", err)
+		return
+	}
+	if issue != nil {
+		_, _, err = repo.client.Issues.CreateComment(context.Background(), repo.owner, repo.repo, issue.GetNumber(), &github.IssueComment{
+			Body: github.String(report),
+		})
+	} else {
+		_, _, err = repo.client.Issues.Create(context.Background(), repo.owner, repo.repo, &github.IssueRequest{
+			Title:  github.String(title),
+			Body:   github.String(report),
+			Labels: &[]string{failureIssueLabel},
+		})
+	}
+	if err != nil {
+		log.Printf("warning: failed to report the release failure: %v", err)
+	}
+}
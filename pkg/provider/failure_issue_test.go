@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubFailureIssue(t *testing.T) {
+	t.Setenv("GITHUB_SERVER_URL", "https://github.com")
+	t.Setenv("GITHUB_REPOSITORY", "owner/test-repo")
+	t.Setenv("GITHUB_RUN_ID", "42")
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_failure_issue": "true"})
+	defer ts.Close()
+	rec.handle("POST /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message":"Resource not accessible by integration"}`, http.StatusForbidden)
+	})
+	var existing []*github.Issue
+	rec.handle("GET /repos/owner/test-repo/issues", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "release-failure", r.URL.Query().Get("labels"))
+		_ = json.NewEncoder(w).Encode(existing)
+	})
+	var created *github.IssueRequest
+	rec.handle("POST /repos/owner/test-repo/issues", func(w http.ResponseWriter, r *http.Request) {
+		created = &github.IssueRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(created))
+		fmt.Fprint(w, `{"number":7}`)
+	})
+	var comment *github.IssueComment
+	rec.handle("POST /repos/owner/test-repo/issues/7/comments", func(w http.ResponseWriter, r *http.Request) {
+		comment = &github.IssueComment{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(comment))
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.ErrorContains(t, err, "Resource not accessible by integration")
+	require.Equal(t, "Release v2.0.0 failed", created.GetTitle())
+	require.Equal(t, []string{"release-failure"}, created.GetLabels())
+	require.Contains(t, created.GetBody(), "Resource not accessible by integration")
+	require.Contains(t, created.GetBody(), "**Run**: https://github.com/owner/test-repo/actions/runs/42")
+	require.Nil(t, comment)
+
+	existing = []*github.Issue{{Number: github.Int(7), Title: github.String("Release v2.0.0 failed")}}
+	err = repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.Error(t, err)
+	require.Contains(t, comment.GetBody(), "The release of v2.0.0 failed")
+}
@@ -0,0 +1,38 @@
+package provider
+
+import "github.com/google/go-github/v66/github"
+
+// filterFirstParent keeps only the commits on the first-parent chain of head. The commits are in reverse
+// chronological order when they were listed and in chronological order when they were compared.
+func filterFirstParent(commits []*github.RepositoryCommit, head string, compared bool) []*github.RepositoryCommit {
+	if len(commits) == 0 {
+		return commits
+	}
+	bySHA := make(map[string]*github.RepositoryCommit, len(commits))
+	for _, commit := range commits {
+		bySHA[commit.GetSHA()] = commit
+	}
+	current, ok := bySHA[head]
+	if !ok {
+		// head might be a branch name
+		current = commits[0]
+		if compared {
+			current = commits[len(commits)-1]
+		}
+	}
+	onChain := make(map[string]bool)
+	for current != nil && !onChain[current.GetSHA()] {
+		onChain[current.GetSHA()] = true
+		if len(current.Parents) == 0 {
+			break
+		}
+		current = bySHA[current.Parents[0].GetSHA()]
+	}
+	filtered := make([]*github.RepositoryCommit, 0, len(onChain))
+	for _, commit := range commits {
+		if onChain[commit.GetSHA()] {
+			filtered = append(filtered, commit)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func createGithubCommitWithParents(sha, message string, parents ...string) *github.RepositoryCommit {
+	commit := createGithubCommit(sha, message)
+	for _, parent := range parents {
+		commit.Parents = append(commit.Parents, &github.Commit{SHA: github.String(parent)})
+	}
+	return commit
+}
+
+// merge (m2) of feature commits f1 and f2 into main after m1
+var firstParentCommits = []*github.RepositoryCommit{
+	createGithubCommitWithParents("m2", "feat: merge feature", "m1", "f2"),
+	createGithubCommitWithParents("f2", "fix: feature part 2", "f1"),
+	createGithubCommitWithParents("m1", "fix: on main", "base"),
+	createGithubCommitWithParents("f1", "feat: feature part 1", "base"),
+	createGithubCommitWithParents("base", "chore: base"),
+}
+
+func TestFilterFirstParent(t *testing.T) {
+	shas := func(commits []*github.RepositoryCommit) []string {
+		result := make([]string, 0, len(commits))
+		for _, c := range commits {
+			result = append(result, c.GetSHA())
+		}
+		return result
+	}
+	require.Equal(t, []string{"m2", "m1", "base"}, shas(filterFirstParent(firstParentCommits, "m2", false)))
+	require.Equal(t, []string{"m2", "m1", "base"}, shas(filterFirstParent(firstParentCommits, "main", false)))
+	require.Equal(t, []string{"f2", "f1", "base"}, shas(filterFirstParent(firstParentCommits, "f2", false)))
+
+	compared := []*github.RepositoryCommit{firstParentCommits[3], firstParentCommits[2], firstParentCommits[1], firstParentCommits[0]}
+	require.Equal(t, []string{"m1", "m2"}, shas(filterFirstParent(compared, "main", true)))
+	require.Empty(t, filterFirstParent(nil, "main", false))
+}
+
+func TestGithubGetCommitsFirstParent(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_first_parent": "true",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/commits", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(firstParentCommits)
+	})
+
+	commits, err := repo.GetCommits("base", "m2")
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.Equal(t, "m2", commits[0].SHA)
+	require.Equal(t, "m1,f2", commits[0].Annotations["parents"])
+	require.Equal(t, "true", commits[0].Annotations["is_merge"])
+	require.Equal(t, "m1", commits[1].SHA)
+	require.Equal(t, "base", commits[1].Annotations["parents"])
+	require.Equal(t, "false", commits[1].Annotations["is_merge"])
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	breakingChangeRe = regexp.MustCompile(`^BREAKING[ -]CHANGE: `)
+	trailerRe        = regexp.MustCompile(`^[\w-]+(: | #)\S`)
+)
+
+// parseFooters extracts the BREAKING CHANGE footers (including their continuation lines) and the trailers of the
+// last paragraph from a pull request body.
+func parseFooters(body string) []string {
+	body = strings.TrimSpace(strings.ReplaceAll(body, "\r\n", "\n"))
+	if body == "" {
+		return nil
+	}
+	footers := make([]string, 0)
+	var current []string
+	flush := func() {
+		if current != nil {
+			footers = append(footers, strings.Join(current, "\n"))
+			current = nil
+		}
+	}
+	for _, line := range strings.Split(body, "\n") {
+		switch {
+		case breakingChangeRe.MatchString(line):
+			flush()
+			current = []string{line}
+		case strings.TrimSpace(line) == "" || trailerRe.MatchString(line):
+			flush()
+		case current != nil:
+			current = append(current, line)
+		}
+	}
+	flush()
+
+	paragraphs := strings.Split(body, "\n\n")
+	lastParagraph := strings.Split(strings.TrimSpace(paragraphs[len(paragraphs)-1]), "\n")
+	for _, line := range lastParagraph {
+		if !trailerRe.MatchString(line) && !breakingChangeRe.MatchString(line) {
+			return footers
+		}
+	}
+	for _, line := range lastParagraph {
+		if !breakingChangeRe.MatchString(line) {
+			footers = append(footers, line)
+		}
+	}
+	return footers
+}
+
+// appendFooters appends the footers that are not part of the message yet.
+func appendFooters(message string, footers []string) string {
+	missing := make([]string, 0, len(footers))
+	for _, footer := range footers {
+		if !strings.Contains(message, footer) {
+			missing = append(missing, footer)
+		}
+	}
+	if len(missing) == 0 {
+		return message
+	}
+	return strings.TrimRight(message, "\n") + "\n\n" + strings.Join(missing, "\n")
+}
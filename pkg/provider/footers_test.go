@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFooters(t *testing.T) {
+	testCases := []struct {
+		body    string
+		footers []string
+	}{
+		{"", nil},
+		{"Adds a feature", []string{}},
+		{"Adds a feature\n\nBREAKING CHANGE: the config\nformat changed\n\nThanks!", []string{"BREAKING CHANGE: the config\nformat changed"}},
+		{"Adds a feature\r\n\r\nBREAKING-CHANGE: removed v1\r\nRefs: #12\r\nReviewed-by: alice", []string{"BREAKING-CHANGE: removed v1", "Refs: #12", "Reviewed-by: alice"}},
+		{"Fixes #3\nCloses #4", []string{"Fixes #3", "Closes #4"}},
+		{"Some text: not a trailer\nmore text", []string{}},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.footers, parseFooters(tc.body), tc.body)
+	}
+}
+
+func TestAppendFooters(t *testing.T) {
+	require.Equal(t, "feat: x\n\nRefs: #1", appendFooters("feat: x\n", []string{"Refs: #1"}))
+	require.Equal(t, "feat: x\n\nRefs: #1", appendFooters("feat: x\n\nRefs: #1", []string{"Refs: #1"}))
+	require.Equal(t, "feat: x", appendFooters("feat: x", nil))
+}
+
+func TestGithubPullRequestFooters(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_pull_request_footers": "true",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/commits/1111/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		pr := createGithubPullRequest(7, "feat", "alice")
+		pr.MergeCommitSHA = github.String("1111")
+		pr.Body = github.String("Reworks the API\n\nBREAKING CHANGE: the API changed")
+		_ = json.NewEncoder(w).Encode([]*github.PullRequest{pr})
+	})
+	rec.handle("GET /repos/owner/test-repo/commits/abcd/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		// merge commits are not squash merges, the pull request body is ignored
+		pr := createGithubPullRequest(8, "feat", "alice")
+		pr.MergeCommitSHA = github.String("ffff")
+		pr.Body = github.String("BREAKING CHANGE: ignored")
+		_ = json.NewEncoder(w).Encode([]*github.PullRequest{pr})
+	})
+	for _, sha := range []string{"dcba", "cdba", "efcd"} {
+		rec.handle("GET /repos/owner/test-repo/commits/"+sha+"/pulls", func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode([]*github.PullRequest{})
+		})
+	}
+
+	commits, err := repo.GetCommits("2222", "1111")
+	require.NoError(t, err)
+	require.Equal(t, "feat: to\n\nBREAKING CHANGE: the API changed", commits[0].RawMessage)
+	require.Equal(t, "feat(app): new feature", commits[1].RawMessage)
+	require.NotContains(t, commits[0].Annotations, "pr_number")
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// forkNetwork returns the full name of the root repository of the fork network of the repository.
+func (repo *GitHubRepository) forkNetwork(owner, name string) (string, error) {
+	r, _, err := repo.client.Repositories.Get(context.Background(), owner, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get repository %s/%s: %w", owner, name, err)
+	}
+	return forkNetworkRoot(r), nil
+}
+
+func forkNetworkRoot(r *github.Repository) string {
+	if source := r.GetSource().GetFullName(); source != "" {
+		return source
+	}
+	return r.GetFullName()
+}
+
+// checkForkNetwork ensures that the repository of the commits and the release repository belong to the same fork
+// network, only then the released commit can be tagged in the release repository.
+func (repo *GitHubRepository) checkForkNetwork() error {
+	source, err := repo.forkNetwork(repo.owner, repo.repo)
+	if err != nil {
+		return err
+	}
+	target, err := repo.forkNetwork(repo.releaseOwner, repo.releaseRepo)
+	if err != nil {
+		return err
+	}
+	if source != target {
+		return fmt.Errorf("%s/%s and %s/%s are not forks of the same repository, github_fork_release can not be used", repo.owner, repo.repo, repo.releaseOwner, repo.releaseRepo)
+	}
+	return nil
+}
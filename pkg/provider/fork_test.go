@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func getNewForkReleaseTestRepo(t *testing.T, source string) (*GitHubRepository, *githubRecorder, func()) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_release_slug": "upstream/test-repo",
+		"github_fork_release": "true",
+	})
+	rec.handle("GET /repos/owner/test-repo", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.Repository{FullName: github.String("owner/test-repo"), Source: &github.Repository{FullName: &source}})
+	})
+	rec.handle("GET /repos/upstream/test-repo", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"full_name":"upstream/test-repo"}`)
+	})
+	for _, route := range []string{"GET /repos/upstream/test-repo/releases", "GET /repos/upstream/test-repo/rulesets", "GET /repos/upstream/test-repo/git/matching-refs/tags"} {
+		rec.handle(route, func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, "[]")
+		})
+	}
+	rec.handle("GET /repos/upstream/test-repo/releases/tags/v2.0.0", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+	return repo, rec, ts.Close
+}
+
+func TestGithubForkRelease(t *testing.T) {
+	repo, rec, closeServer := getNewForkReleaseTestRepo(t, "upstream/test-repo")
+	defer closeServer()
+	var tagRef map[string]interface{}
+	rec.handle("POST /repos/upstream/test-repo/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&tagRef))
+		fmt.Fprint(w, "{}")
+	})
+	var created github.RepositoryRelease
+	rec.handle("POST /repos/upstream/test-repo/releases", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "feature"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"ref": "refs/tags/v2.0.0", "sha": testSHA}, tagRef)
+	require.Equal(t, "v2.0.0", created.GetTagName())
+	require.Equal(t, testSHA, created.GetTargetCommitish())
+}
+
+func TestGithubForkReleaseOtherNetwork(t *testing.T) {
+	repo, rec, closeServer := getNewForkReleaseTestRepo(t, "someone/else")
+	defer closeServer()
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "feature"})
+	require.EqualError(t, err, "owner/test-repo and upstream/test-repo are not forks of the same repository, github_fork_release can not be used")
+	require.Nil(t, rec.lastRelease())
+}
+
+func TestGithubForkReleaseRequiresReleaseSlug(t *testing.T) {
+	err := (&GitHubRepository{}).Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_fork_release": "true"})
+	require.EqualError(t, err, "github_fork_release requires github_release_slug")
+}
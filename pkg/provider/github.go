@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"net/http"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
 	"github.com/go-semantic-release/semantic-release/v2/pkg/semrel"
 	"github.com/google/go-github/v66/github"
@@ -20,14 +24,131 @@ import (
 var PVERSION = "dev"
 
 type GitHubRepository struct {
-	owner           string
-	repo            string
-	stripVTagPrefix bool
-	client          *github.Client
-	compareCommits  bool
+	owner                  string
+	repo                   string
+	releaseOwner           string
+	releaseRepo            string
+	tagOwner               string
+	tagRepo                string
+	forkRelease            bool
+	mirrorRepos            []mirrorRepo
+	mirrorTags             bool
+	stripVTagPrefix        bool
+	client                 *github.Client
+	compareCommits         bool
+	useGraphQL             bool
+	permissionCheck        bool
+	checksumsFile          string
+	assetFiles             []string
+	assetsManifest         string
+	assetHeaders           []*assetHeader
+	assetArchives          []*assetArchive
+	splitLargeAssets       bool
+	gpgEntity              *openpgp.Entity
+	minisignKey            *minisignKey
+	provenance             bool
+	sbomFiles              []string
+	sbomNameTemplate       *template.Template
+	sourceArchive          bool
+	releaseDraft           bool
+	atomicRelease          bool
+	generateReleaseNotes   string
+	releaseBodyTemplate    *template.Template
+	releaseNameTemplate    *template.Template
+	changelogHeader        string
+	changelogFooter        string
+	fullChangelogLink      bool
+	pullRequestList        bool
+	contributors           bool
+	newContributors        bool
+	autolinkReferences     bool
+	mentions               string
+	displayNames           string
+	displayNameCache       map[string]string
+	releaseBodyOverflow    string
+	collapseThreshold      int
+	annotatedTags          bool
+	taggerName             string
+	taggerEmail            string
+	signTags               bool
+	tagFormat              *tagFormat
+	tagPrefix              string
+	updateMajorTags        bool
+	updateMinorTags        bool
+	verifyBranch           bool
+	branchMoved            string
+	releaseLock            bool
+	releaseLockTTL         time.Duration
+	cleanupPrereleases     string
+	markSuperseded         bool
+	promotePrereleases     bool
+	pullRequestAnnotations bool
+	pullRequestFooters     bool
+	pullRequestReviews     bool
+	redactEmails           string
+	releasesBranch         string
+	tagExclude             *regexp.Regexp
+	tagVersionParsing      string
+	skipDraftReleases      bool
+	skipPrereleaseReleases bool
+	releasesWithoutTags    bool
+	fileAnnotations        bool
+	pathFilter             *pathFilter
+	firstParent            bool
+	requireSignedCommits   bool
+	requireSignoff         bool
+	ignoreAuthors          []*regexp.Regexp
+	maxCommits             int
+	commitsSince           time.Time
+	repoAnnotations        map[string]string
+	releaseAnnotations     map[string]string
+	metricsPushgateway     string
+	metricsServer          *http.Server
+	defaultSHA             string
+	defaultBranch          string
+	info                   *provider.RepositoryInfo
+	commitStatusContext    string
+	deploymentEnvironment  string
+	approvalEnvironment    string
+	approvalTimeout        time.Duration
+	releasedLabels         []*template.Template
+	releasedLabelsRemove   []*template.Template
+	milestones             bool
+	milestoneTitleTemplate *template.Template
+	nextMilestone          string
+	nextMilestoneTitle     *template.Template
+	announcementCategory   string
+	announcementOwner      string
+	announcementRepo       string
+	announcementTitle      *template.Template
+	notifyTeams            []string
+	webhookURL             string
+	webhookSecret          string
+	trackingIssue          bool
+	trackingIssueChecklist string
+	failureIssue           bool
+	mergeBackBranch        string
+	mergeBackMode          string
+	maintenanceBranches    bool
+	maintenanceTemplate    *template.Template
+	changelogUpdate        string
+	changelogFile          string
+	changelogAutoMerge     bool
+	versionFiles           []*versionFile
+	badge                  bool
+	badgeLabel             string
+	badgePath              string
+	badgeBranch            string
+	dependabotGate         []string
+	dependabotGateAction   string
+	codeScanningGate       map[string]bool
+	codeScanningOverride   bool
+	secretScanningGate     bool
+	secretScanningAction   string
 }
 
 func (repo *GitHubRepository) Init(config map[string]string) error {
+	config = withEnvConfig(config)
 	gheHost := config["github_enterprise_host"]
 	if gheHost == "" {
 		gheHost = os.Getenv("GITHUB_ENTERPRISE_HOST")
@@ -44,7 +165,7 @@ func (repo *GitHubRepository) Init(config map[string]string) error {
 		token = os.Getenv("GH_TOKEN")
 	}
 	if token == "" {
-		return errors.New("github token missing")
+		return ErrTokenMissing
 	}
 
 	if !strings.Contains(slug, "/") {
@@ -53,8 +174,31 @@ func (repo *GitHubRepository) Init(config map[string]string) error {
 	split := strings.Split(slug, "/")
 	repo.owner = split[0]
 	repo.repo = split[1]
+	repo.releaseOwner, repo.releaseRepo = repo.owner, repo.repo
+	if releaseSlug := config["github_release_slug"]; releaseSlug != "" {
+		releaseOwner, releaseRepo, ok := strings.Cut(releaseSlug, "/")
+		if !ok || releaseOwner == "" || releaseRepo == "" {
+			return fmt.Errorf("invalid github_release_slug: %s", releaseSlug)
+		}
+		repo.releaseOwner, repo.releaseRepo = releaseOwner, releaseRepo
+	}
+	repo.tagOwner, repo.tagRepo = repo.owner, repo.repo
+	if config["github_fork_release"] == "true" {
+		if config["github_release_slug"] == "" {
+			return errors.New("github_fork_release requires github_release_slug")
+		}
+		// the tags are read from and created in the release repository, the commits are shared by the fork network
+		repo.forkRelease = true
+		repo.tagOwner, repo.tagRepo = repo.releaseOwner, repo.releaseRepo
+	}
+	repo.InvalidateInfo()
+	repo.defaultSHA, repo.defaultBranch = actionsDefaults()
 
 	oauthClient := oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}))
+	oauthClient.Transport = newMetricsTransport(oauthClient.Transport)
+	if auditLog := config["github_audit_log"]; auditLog != "" {
+		oauthClient.Transport = newAuditTransport(oauthClient.Transport, auditLog)
+	}
 	if gheHost != "" {
 		gheURL := fmt.Sprintf("https://%s/api/v3/", gheHost)
 		rClient, err := github.NewClient(oauthClient).WithEnterpriseURLs(gheURL, gheURL)
@@ -69,6 +213,68 @@ func (repo *GitHubRepository) Init(config map[string]string) error {
 	if config["github_use_compare_commits"] == "true" {
 		repo.compareCommits = true
 	}
+	if config["github_use_graphql"] == "true" {
+		repo.useGraphQL = true
+	}
+	if addr := config["github_metrics_addr"]; addr != "" {
+		if err := repo.serveMetrics(addr); err != nil {
+			return err
+		}
+	}
+	repo.metricsPushgateway = config["github_metrics_pushgateway"]
+	if config["github_permission_check"] == "true" {
+		repo.permissionCheck = true
+	}
+	if config["github_pull_request_annotations"] == "true" {
+		repo.pullRequestAnnotations = true
+	}
+	if config["github_pull_request_footers"] == "true" {
+		repo.pullRequestFooters = true
+	}
+	if config["github_pull_request_reviews"] == "true" {
+		repo.pullRequestReviews = true
+	}
+	repo.redactEmails = config["github_redact_emails"]
+	switch repo.redactEmails {
+	case "", redactEmailsOmit, redactEmailsHash:
+	default:
+		return fmt.Errorf("invalid value for github_redact_emails: %s", repo.redactEmails)
+	}
+	if config["github_file_annotations"] == "true" {
+		repo.fileAnnotations = true
+	}
+	if config["github_first_parent"] == "true" {
+		repo.firstParent = true
+	}
+	if config["github_require_signed_commits"] == "true" {
+		repo.requireSignedCommits = true
+	}
+	if config["github_require_signoff"] == "true" {
+		repo.requireSignoff = true
+	}
+	repo.ignoreAuthors = compileAuthorPatterns(splitList(config["ignore_authors"]))
+	repo.pathFilter = newPathFilter(splitList(config["paths"]), splitList(config["paths_ignore"]))
+
+	if config["github_annotated_tags"] == "true" {
+		repo.annotatedTags = true
+	}
+	repo.taggerName = config["github_tagger_name"]
+	repo.taggerEmail = config["github_tagger_email"]
+
+	if config["github_release_draft"] == "true" {
+		repo.releaseDraft = true
+	}
+
+	if config["github_atomic_release"] == "true" {
+		repo.atomicRelease = true
+	}
+
+	repo.generateReleaseNotes = config["github_generate_release_notes"]
+	switch repo.generateReleaseNotes {
+	case "", "false", generateReleaseNotesServer, generateReleaseNotesAppend, generateReleaseNotesClient:
+	default:
+		return fmt.Errorf("invalid value for github_generate_release_notes: %s", repo.generateReleaseNotes)
+	}
 
 	var err error
 	stripVTagPrefix := config["strip_v_tag_prefix"]
@@ -78,98 +284,612 @@ func (repo *GitHubRepository) Init(config map[string]string) error {
 		return fmt.Errorf("failed to set property strip_v_tag_prefix: %w", err)
 	}
 
+	repo.tagFormat, err = parseTagFormat(config["tag_format"])
+	if err != nil {
+		return err
+	}
+	repo.tagPrefix = config["tag_prefix"]
+	repo.releasesBranch = config["github_releases_branch"]
+	if config["github_releases_skip_drafts"] == "true" {
+		repo.skipDraftReleases = true
+	}
+	if config["github_releases_skip_prereleases"] == "true" {
+		repo.skipPrereleaseReleases = true
+	}
+	if config["github_releases_without_tags"] == "true" {
+		repo.releasesWithoutTags = true
+	}
+	if tagExclude := config["tag_exclude"]; tagExclude != "" {
+		repo.tagExclude, err = regexp.Compile(tagExclude)
+		if err != nil {
+			return fmt.Errorf("failed to parse tag_exclude: %w", err)
+		}
+	}
+	if err := repo.applyPackage(config["packages"], config["package"]); err != nil {
+		return err
+	}
+	if maxCommits := config["github_max_commits"]; maxCommits != "" {
+		repo.maxCommits, err = strconv.Atoi(maxCommits)
+		if err != nil || repo.maxCommits < 0 {
+			return fmt.Errorf("invalid value for github_max_commits: %s", maxCommits)
+		}
+	}
+	repo.commitsSince, err = parseCommitsSince(config["github_commits_since"])
+	if err != nil {
+		return err
+	}
+	if config["github_update_major_tags"] == "true" {
+		repo.updateMajorTags = true
+	}
+	if config["github_update_minor_tags"] == "true" {
+		repo.updateMinorTags = true
+	}
+	repo.tagVersionParsing = config["tag_version_parsing"]
+	switch repo.tagVersionParsing {
+	case "", tagVersionParsingStrict:
+	case tagVersionParsingCoerce:
+		if repo.updateMajorTags || repo.updateMinorTags {
+			return errors.New("tag_version_parsing=coerce can not be used with floating major or minor tags")
+		}
+	default:
+		return fmt.Errorf("invalid value for tag_version_parsing: %s", repo.tagVersionParsing)
+	}
+	if config["github_verify_branch"] == "true" {
+		repo.verifyBranch = true
+	}
+	if config["github_release_lock"] == "true" {
+		repo.releaseLock = true
+	}
+	repo.releaseLockTTL = defaultReleaseLockTTL
+	if ttl := config["github_release_lock_ttl"]; ttl != "" {
+		repo.releaseLockTTL, err = time.ParseDuration(ttl)
+		if err != nil {
+			return fmt.Errorf("failed to parse github_release_lock_ttl: %w", err)
+		}
+	}
+	repo.releasedLabels, err = parseLabelTemplates("github_released_labels", config["github_released_labels"])
+	if err != nil {
+		return err
+	}
+	repo.releasedLabelsRemove, err = parseLabelTemplates("github_released_labels_remove", config["github_released_labels_remove"])
+	if err != nil {
+		return err
+	}
+	if config["github_milestones"] == "true" {
+		repo.milestones = true
+	}
+	milestoneTitle := config["github_milestone_title"]
+	if milestoneTitle == "" {
+		milestoneTitle = defaultMilestoneTitle
+	}
+	repo.milestoneTitleTemplate, err = parseMilestoneTitle("github_milestone_title", milestoneTitle)
+	if err != nil {
+		return err
+	}
+	repo.nextMilestone = config["github_next_milestone"]
+	switch repo.nextMilestone {
+	case "":
+	case nextMilestonePatch, nextMilestoneMinor, nextMilestoneMajor:
+		if !repo.milestones {
+			return errors.New("github_next_milestone requires github_milestones")
+		}
+	default:
+		return fmt.Errorf("invalid value for github_next_milestone: %s", repo.nextMilestone)
+	}
+	nextTitle := config["github_next_milestone_title"]
+	if nextTitle == "" {
+		nextTitle = milestoneTitle
+	}
+	repo.nextMilestoneTitle, err = parseMilestoneTitle("github_next_milestone_title", nextTitle)
+	if err != nil {
+		return err
+	}
+	repo.notifyTeams, err = parseNotifyTeams(config["github_notify_teams"])
+	if err != nil {
+		return err
+	}
+	repo.announcementCategory = config["github_announcement_category"]
+	repo.announcementOwner, repo.announcementRepo, err = repo.parseAnnouncementRepo(config["github_announcement_repo"])
+	if err != nil {
+		return err
+	}
+	repo.announcementTitle, err = parseAnnouncementTitle(config["github_announcement_title"])
+	if err != nil {
+		return err
+	}
+	repo.webhookURL = config["github_release_webhook_url"]
+	repo.webhookSecret = config["github_release_webhook_secret"]
+	if repo.webhookSecret == "" {
+		repo.webhookSecret = os.Getenv("GITHUB_RELEASE_WEBHOOK_SECRET")
+	}
+	repo.dependabotGate, err = parseSeverityThreshold("github_dependabot_gate", config["github_dependabot_gate"])
+	if err != nil {
+		return err
+	}
+	repo.dependabotGateAction, err = parseGateAction("github_dependabot_gate_action", config["github_dependabot_gate_action"])
+	if err != nil {
+		return err
+	}
+	repo.codeScanningGate, err = parseCodeScanningGate(config["github_code_scanning_gate"])
+	if err != nil {
+		return err
+	}
+	if config["github_code_scanning_override"] == "true" {
+		repo.codeScanningOverride = true
+	}
+	if config["github_secret_scanning_gate"] == "true" {
+		repo.secretScanningGate = true
+	}
+	repo.secretScanningAction, err = parseGateAction("github_secret_scanning_gate_action", config["github_secret_scanning_gate_action"])
+	if err != nil {
+		return err
+	}
+	repo.mirrorRepos, err = parseMirrorRepos(config["github_mirror_repos"])
+	if err != nil {
+		return err
+	}
+	if config["github_mirror_tags"] == "true" {
+		repo.mirrorTags = true
+	}
+	repo.approvalEnvironment = config["github_approval_environment"]
+	repo.approvalTimeout = defaultApprovalTimeout
+	if timeout := config["github_approval_timeout"]; timeout != "" {
+		repo.approvalTimeout, err = time.ParseDuration(timeout)
+		if err != nil {
+			return fmt.Errorf("failed to parse github_approval_timeout: %w", err)
+		}
+	}
+	repo.branchMoved = config["github_branch_moved"]
+	switch repo.branchMoved {
+	case "", branchMovedFail, branchMovedWarn:
+	default:
+		return fmt.Errorf("invalid value for github_branch_moved: %s", repo.branchMoved)
+	}
+
+	repo.releaseBodyTemplate, err = parseReleaseBodyTemplate(config["github_release_body_template"], config["github_release_body_template_file"])
+	if err != nil {
+		return err
+	}
+	if threshold := config["github_collapse_threshold"]; threshold != "" {
+		repo.collapseThreshold, err = strconv.Atoi(threshold)
+		if err != nil || repo.collapseThreshold < 1 {
+			return fmt.Errorf("invalid value for github_collapse_threshold: %s", threshold)
+		}
+	}
+	repo.releaseNameTemplate, err = parseReleaseName(config["github_release_name"])
+	if err != nil {
+		return err
+	}
+	if config["github_full_changelog_link"] == "true" {
+		repo.fullChangelogLink = true
+	}
+	if config["github_pull_request_list"] == "true" {
+		repo.pullRequestList = true
+	}
+	if config["github_contributors"] == "true" {
+		repo.contributors = true
+	}
+	if config["github_new_contributors"] == "true" {
+		repo.contributors = true
+		repo.newContributors = true
+	}
+	if config["github_autolink_references"] == "true" {
+		repo.autolinkReferences = true
+	}
+	repo.mentions = config["github_mentions"]
+	switch repo.mentions {
+	case "", mentionsEscape, mentionsStrip:
+	default:
+		return fmt.Errorf("invalid value for github_mentions: %s", repo.mentions)
+	}
+	repo.displayNames = config["github_display_names"]
+	switch repo.displayNames {
+	case "", displayNamesAppend, displayNamesReplace:
+	default:
+		return fmt.Errorf("invalid value for github_display_names: %s", repo.displayNames)
+	}
+	repo.displayNameCache = make(map[string]string)
+	if config["github_promote_prereleases"] == "true" {
+		repo.promotePrereleases = true
+	}
+	if config["github_commit_status"] == "true" {
+		repo.commitStatusContext = config["github_commit_status_context"]
+		if repo.commitStatusContext == "" {
+			repo.commitStatusContext = defaultCommitStatusContext
+		}
+	}
+	repo.deploymentEnvironment = config["github_deployment_environment"]
+	if config["github_mark_superseded"] == "true" {
+		repo.markSuperseded = true
+	}
+	repo.cleanupPrereleases = config["github_cleanup_prereleases"]
+	switch repo.cleanupPrereleases {
+	case "", cleanupPrereleasesDelete, cleanupPrereleasesDraft:
+	default:
+		return fmt.Errorf("invalid value for github_cleanup_prereleases: %s", repo.cleanupPrereleases)
+	}
+	repo.releaseBodyOverflow = config["github_release_body_overflow"]
+	switch repo.releaseBodyOverflow {
+	case "", releaseBodyOverflowTruncate, releaseBodyOverflowAsset:
+	default:
+		return fmt.Errorf("invalid value for github_release_body_overflow: %s", repo.releaseBodyOverflow)
+	}
+	repo.changelogHeader, err = readInlineOrFile(config["changelog_header"])
+	if err != nil {
+		return fmt.Errorf("failed to read changelog_header: %w", err)
+	}
+	repo.changelogFooter, err = readInlineOrFile(config["changelog_footer"])
+	if err != nil {
+		return fmt.Errorf("failed to read changelog_footer: %w", err)
+	}
+	repo.mergeBackBranch = config["github_merge_back_branch"]
+	repo.mergeBackMode = config["github_merge_back_mode"]
+	switch repo.mergeBackMode {
+	case "":
+		repo.mergeBackMode = mergeBackPR
+	case mergeBackPR, mergeBackMerge:
+	default:
+		return fmt.Errorf("invalid value for github_merge_back_mode: %s", repo.mergeBackMode)
+	}
+	if config["github_maintenance_branches"] == "true" {
+		repo.maintenanceBranches = true
+	}
+	repo.maintenanceTemplate, err = parseMaintenanceBranchFormat(config["github_maintenance_branch_format"])
+	if err != nil {
+		return err
+	}
+	repo.versionFiles, err = parseVersionFiles(config["github_version_files"])
+	if err != nil {
+		return err
+	}
+	repo.changelogUpdate = config["github_changelog_update"]
+	switch repo.changelogUpdate {
+	case "", changelogUpdatePR, changelogUpdateCommit:
+	default:
+		return fmt.Errorf("invalid value for github_changelog_update: %s", repo.changelogUpdate)
+	}
+	repo.changelogFile = config["github_changelog_file"]
+	if repo.changelogFile == "" {
+		repo.changelogFile = defaultChangelogFile
+	}
+	if config["github_changelog_auto_merge"] == "true" {
+		repo.changelogAutoMerge = true
+	}
+	if config["github_failure_issue"] == "true" {
+		repo.failureIssue = true
+	}
+	if config["github_tracking_issue"] == "true" {
+		repo.trackingIssue = true
+	}
+	repo.trackingIssueChecklist, err = readInlineOrFile(config["github_tracking_issue_checklist"])
+	if err != nil {
+		return fmt.Errorf("failed to read github_tracking_issue_checklist: %w", err)
+	}
+
+	if config["github_badge"] == "true" {
+		repo.badge = true
+	}
+	repo.badgeLabel = config["github_badge_label"]
+	if repo.badgeLabel == "" {
+		repo.badgeLabel = defaultBadgeLabel
+	}
+	repo.badgePath = config["github_badge_path"]
+	repo.badgeBranch = config["github_badge_branch"]
+	repo.checksumsFile = config["github_checksums_file"]
+	repo.assetFiles = splitList(config["github_assets"])
+	repo.assetsManifest = config["github_assets_manifest"]
+	assetHeaders, err := readInlineOrFile(config["github_assets_headers"])
+	if err != nil {
+		return fmt.Errorf("failed to read github_assets_headers: %w", err)
+	}
+	repo.assetHeaders, err = parseAssetHeaders(assetHeaders)
+	if err != nil {
+		return err
+	}
+	repo.assetArchives, err = parseAssetArchives(config["github_asset_archives"])
+	if err != nil {
+		return err
+	}
+	if config["github_split_large_assets"] == "true" {
+		repo.splitLargeAssets = true
+	}
+	if config["github_provenance"] == "true" {
+		repo.provenance = true
+	}
+	if config["github_source_archive"] == "true" {
+		repo.sourceArchive = true
+	}
+	repo.sbomFiles = splitList(config["github_sbom_files"])
+	repo.sbomNameTemplate, err = parseSBOMNameTemplate(config["github_sbom_name_template"])
+	if err != nil {
+		return err
+	}
+	gpgPrivateKey := config["gpg_private_key"]
+	if gpgPrivateKey == "" {
+		gpgPrivateKey = os.Getenv("GPG_PRIVATE_KEY")
+	}
+	gpgPassphrase := config["gpg_passphrase"]
+	if gpgPassphrase == "" {
+		gpgPassphrase = os.Getenv("GPG_PASSPHRASE")
+	}
+	if gpgPrivateKey != "" {
+		repo.gpgEntity, err = loadGPGEntity(gpgPrivateKey, gpgPassphrase)
+		if err != nil {
+			return err
+		}
+	}
+	minisignPrivateKey := config["minisign_private_key"]
+	if minisignPrivateKey == "" {
+		minisignPrivateKey = os.Getenv("MINISIGN_PRIVATE_KEY")
+	}
+	minisignPassword := config["minisign_password"]
+	if minisignPassword == "" {
+		minisignPassword = os.Getenv("MINISIGN_PASSWORD")
+	}
+	if minisignPrivateKey != "" {
+		repo.minisignKey, err = loadMinisignKey(minisignPrivateKey, minisignPassword)
+		if err != nil {
+			return err
+		}
+	}
+
+	if config["github_sign_tags"] == "true" {
+		if repo.gpgEntity == nil {
+			return errors.New("github_sign_tags requires a gpg private key")
+		}
+		repo.signTags = true
+	}
+
 	return nil
 }
 
-func (repo *GitHubRepository) GetInfo() (*provider.RepositoryInfo, error) {
-	r, _, err := repo.client.Repositories.Get(context.Background(), repo.owner, repo.repo)
+func (repo *GitHubRepository) GetInfo() (_ *provider.RepositoryInfo, err error) {
+	defer func() { err = classifyError(err) }()
+	// the host may call GetInfo multiple times, the result is cached for the lifetime of the plugin process
+	if repo.info != nil {
+		return repo.info, nil
+	}
+	var r *github.Repository
+	oauthScopes := ""
+	if repo.useGraphQL {
+		r, err = repo.getRepositoryGraphQL()
+	} else {
+		var resp *github.Response
+		r, resp, err = repo.client.Repositories.Get(context.Background(), repo.owner, repo.repo)
+		if resp != nil {
+			oauthScopes = resp.Header.Get("X-OAuth-Scopes")
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
-	return &provider.RepositoryInfo{
+	// tags and releases of archived repositories are read-only
+	if r.GetArchived() {
+		return nil, withSentinel(ErrRepoArchived, fmt.Errorf("repository %s/%s is archived and can not be released", repo.owner, repo.repo))
+	}
+	if repo.permissionCheck {
+		if err := repo.checkPermissions(r.Permissions, oauthScopes, r.GetPrivate()); err != nil {
+			return nil, err
+		}
+	}
+	repo.repoAnnotations = repositoryAnnotations(r)
+	repo.info = &provider.RepositoryInfo{
 		Owner:         r.GetOwner().GetLogin(),
 		Repo:          r.GetName(),
 		DefaultBranch: r.GetDefaultBranch(),
 		Private:       r.GetPrivate(),
-	}, nil
+	}
+	return repo.info, nil
+}
+
+// isEmptyRepository reports whether the request failed because the repository does not have any commits yet.
+func isEmptyRepository(resp *github.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusConflict
 }
 
-func (repo *GitHubRepository) getCommitsFromGithub(compareCommits bool, fromSha, toSha string, opts *github.ListOptions) ([]*github.RepositoryCommit, *github.Response, error) {
+func (repo *GitHubRepository) getCommitsFromGithub(compareCommits bool, fromSha, toSha string, opts *github.ListOptions) ([]*github.RepositoryCommit, int, *github.Response, error) {
 	if !compareCommits {
-		return repo.client.Repositories.ListCommits(context.Background(), repo.owner, repo.repo, &github.CommitsListOptions{
+		commits, resp, err := repo.client.Repositories.ListCommits(context.Background(), repo.owner, repo.repo, &github.CommitsListOptions{
 			SHA:         toSha,
+			Since:       repo.commitsSince,
 			ListOptions: *opts,
 		})
+		return commits, 0, resp, err
 	}
 	compCommits, resp, err := repo.client.Repositories.CompareCommits(context.Background(), repo.owner, repo.repo, fromSha, toSha, opts)
 	if err != nil {
-		return nil, nil, err
+		return nil, 0, resp, err
 	}
-	return compCommits.Commits, resp, nil
+	return compCommits.Commits, compCommits.GetTotalCommits(), resp, nil
 }
 
-func (repo *GitHubRepository) GetCommits(fromSha, toSha string) ([]*semrel.RawCommit, error) {
-	compareCommits := repo.compareCommits
-	if compareCommits && fromSha == "" {
-		// we want all commits for the first release, hence disable compareCommits
-		compareCommits = false
-	}
-	allCommits := make([]*semrel.RawCommit, 0)
+// fetchCommits returns the commits between fromSha and toSha and whether they were compared (chronological order)
+// or listed (reverse chronological order).
+func (repo *GitHubRepository) fetchCommits(compareCommits bool, fromSha, toSha string, limit int) ([]*github.RepositoryCommit, bool, error) {
+	listedCommits := make([]*github.RepositoryCommit, 0)
 	opts := &github.ListOptions{PerPage: 100}
 	done := false
+	comparedCommits, totalCommits := 0, 0
 	for {
-		commits, resp, err := repo.getCommitsFromGithub(compareCommits, fromSha, toSha, opts)
+		commits, total, resp, err := repo.getCommitsFromGithub(compareCommits, fromSha, toSha, opts)
+		if isEmptyRepository(resp) {
+			return listedCommits, compareCommits, nil
+		}
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
+		comparedCommits += len(commits)
+		totalCommits = total
 		for _, commit := range commits {
-			sha := commit.GetSHA()
 			// compare commits already returns the relevant commits and no extra filtering is needed
-			if !compareCommits && sha == fromSha {
+			if !compareCommits && commit.GetSHA() == fromSha {
+				done = true
+				break
+			}
+			if compareCommits && commit.Commit.GetCommitter().GetDate().Before(repo.commitsSince) {
+				continue
+			}
+			if limit > 0 && len(listedCommits) == limit {
+				log.Printf("warning: the commit range was truncated to %d commits", limit)
 				done = true
 				break
 			}
-			allCommits = append(allCommits, &semrel.RawCommit{
-				SHA:        sha,
-				RawMessage: commit.Commit.GetMessage(),
-				Annotations: map[string]string{
-					"author_login":    commit.GetAuthor().GetLogin(),
-					"author_name":     commit.Commit.GetAuthor().GetName(),
-					"author_email":    commit.Commit.GetAuthor().GetEmail(),
-					"author_date":     commit.Commit.GetAuthor().GetDate().Format(time.RFC3339),
-					"committer_login": commit.GetCommitter().GetLogin(),
-					"committer_name":  commit.Commit.GetCommitter().GetName(),
-					"committer_email": commit.Commit.GetCommitter().GetEmail(),
-					"committer_date":  commit.Commit.GetCommitter().GetDate().Format(time.RFC3339),
-				},
-			})
+			listedCommits = append(listedCommits, commit)
 		}
 		if done || resp.NextPage == 0 {
 			break
 		}
 		opts.Page = resp.NextPage
 	}
+	// the compare API caps the number of commits of large ranges, in this case the history is walked instead
+	if compareCommits && !done && comparedCommits < totalCommits {
+		log.Printf("warning: the comparison %s...%s only returned %d of %d commits, listing the commits instead", fromSha, toSha, comparedCommits, totalCommits)
+		return repo.fetchCommits(false, fromSha, toSha, limit)
+	}
+	if !done && !compareCommits && !repo.commitsSince.IsZero() {
+		log.Printf("warning: the commit range was truncated to the commits since %s (github_commits_since)", repo.commitsSince.Format(time.RFC3339))
+	}
+	return listedCommits, compareCommits, nil
+}
+
+func (repo *GitHubRepository) GetCommits(fromSha, toSha string) (_ []*semrel.RawCommit, err error) {
+	defer func() { err = classifyError(err) }()
+	if toSha == "" {
+		toSha = repo.defaultSHA
+	}
+	compareCommits := repo.compareCommits
+	if compareCommits && fromSha == "" {
+		// we want all commits for the first release, hence disable compareCommits
+		compareCommits = false
+	}
+	limit := repo.maxCommits
+	if fromSha != "" {
+		isAncestor, err := repo.isAncestor(fromSha, toSha)
+		if err != nil {
+			return nil, err
+		}
+		if !isAncestor {
+			// e.g. after a force push, walking the history would never reach fromSha
+			log.Printf("warning: %s is not an ancestor of %s, only the latest commits are analyzed", fromSha, toSha)
+			fromSha, compareCommits = "", false
+			if limit == 0 || limit > divergedCommitsLimit {
+				limit = divergedCommitsLimit
+			}
+		}
+	}
+	listedCommits, compareCommits, err := repo.fetchCommits(compareCommits, fromSha, toSha, limit)
+	if err != nil {
+		return nil, err
+	}
+	// the gates check every commit of the range, including the ones of merged branches and the ones beyond
+	// github_max_commits
+	rangeCommits := listedCommits
+	if (repo.requireSignedCommits || repo.requireSignoff) && fromSha != "" && limit > 0 && len(listedCommits) == limit {
+		rangeCommits, _, err = repo.fetchCommits(compareCommits, fromSha, toSha, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if repo.requireSignedCommits {
+		if err := checkSignedCommits(rangeCommits); err != nil {
+			return nil, err
+		}
+	}
+	if repo.requireSignoff {
+		if err := checkSignoff(rangeCommits); err != nil {
+			return nil, err
+		}
+	}
+	if repo.firstParent {
+		listedCommits = filterFirstParent(listedCommits, toSha, compareCommits)
+	}
+
+	allCommits := make([]*semrel.RawCommit, 0, len(listedCommits))
+	for _, commit := range listedCommits {
+		if isIgnoredAuthor(repo.ignoreAuthors, commit) {
+			continue
+		}
+		sha := commit.GetSHA()
+		parents := make([]string, 0, len(commit.Parents))
+		for _, parent := range commit.Parents {
+			parents = append(parents, parent.GetSHA())
+		}
+		rawCommit := &semrel.RawCommit{
+			SHA:        sha,
+			RawMessage: commit.Commit.GetMessage(),
+			Annotations: map[string]string{
+				"author_login":    commit.GetAuthor().GetLogin(),
+				"author_name":     commit.Commit.GetAuthor().GetName(),
+				"author_email":    commit.Commit.GetAuthor().GetEmail(),
+				"author_date":     commit.Commit.GetAuthor().GetDate().Format(time.RFC3339),
+				"committer_login": commit.GetCommitter().GetLogin(),
+				"committer_name":  commit.Commit.GetCommitter().GetName(),
+				"committer_email": commit.Commit.GetCommitter().GetEmail(),
+				"committer_date":  commit.Commit.GetCommitter().GetDate().Format(time.RFC3339),
+				"commit_url":      repo.commitURL(commit),
+				"parents":         strings.Join(parents, ","),
+				"is_merge":        strconv.FormatBool(len(parents) > 1),
+			},
+		}
+		repo.annotateRepository(rawCommit)
+		if repo.redactEmails != "" {
+			redactEmails(rawCommit, repo.redactEmails)
+		}
+		if repo.fileAnnotations || repo.pathFilter != nil {
+			files, err := repo.listCommitFiles(sha)
+			if err != nil {
+				return nil, err
+			}
+			if repo.pathFilter != nil && !repo.pathFilter.matches(files) {
+				continue
+			}
+			if repo.fileAnnotations {
+				annotateFiles(rawCommit, files)
+			}
+		}
+		if repo.pullRequestAnnotations || repo.pullRequestFooters || repo.pullRequestReviews {
+			if err := repo.enrichCommitFromPullRequest(rawCommit); err != nil {
+				return nil, err
+			}
+		}
+		allCommits = append(allCommits, rawCommit)
+	}
 	return allCommits, nil
 }
 
 //gocyclo:ignore
-func (repo *GitHubRepository) GetReleases(rawRe string) ([]*semrel.Release, error) {
+func (repo *GitHubRepository) GetReleases(rawRe string) (_ []*semrel.Release, err error) {
+	defer func() { err = classifyError(err) }()
 	re := regexp.MustCompile(rawRe)
-	allReleases := make([]*semrel.Release, 0)
-	opts := &github.ReferenceListOptions{Ref: "tags", ListOptions: github.ListOptions{PerPage: 100}}
+	allReleases := make([]*taggedRelease, 0)
+	reachableCache := make(map[string]bool)
+	seenTags := make(map[string]bool)
+	var githubReleases map[string]*github.RepositoryRelease
+	if repo.skipDraftReleases || repo.skipPrereleaseReleases || repo.releasesWithoutTags {
+		var err error
+		githubReleases, err = repo.listGitHubReleases()
+		if err != nil {
+			return nil, err
+		}
+	}
+	opts := &github.ReferenceListOptions{Ref: repo.tagsRef(), ListOptions: github.ListOptions{PerPage: 100}}
 	for {
-		refs, resp, err := repo.client.Git.ListMatchingRefs(context.Background(), repo.owner, repo.repo, opts)
-		if resp != nil && resp.StatusCode == 404 {
-			return allReleases, nil
+		refs, resp, err := repo.client.Git.ListMatchingRefs(context.Background(), repo.tagOwner, repo.tagRepo, opts)
+		if resp != nil && resp.StatusCode == 404 || isEmptyRepository(resp) {
+			break
 		}
 		if err != nil {
 			return nil, err
 		}
 		for _, r := range refs {
 			tag := strings.TrimPrefix(r.GetRef(), "refs/tags/")
+			seenTags[tag] = true
 			if rawRe != "" && !re.MatchString(tag) {
 				continue
 			}
+			if repo.isExcludedTag(tag) || repo.isSkippedRelease(githubReleases, tag) {
+				continue
+			}
 			objType := r.Object.GetType()
 			if objType != "commit" && objType != "tag" {
 				continue
@@ -177,7 +897,7 @@ func (repo *GitHubRepository) GetReleases(rawRe string) ([]*semrel.Release, erro
 			foundSha := r.Object.GetSHA()
 			// resolve annotated tag
 			if objType == "tag" {
-				resTag, _, err := repo.client.Git.GetTag(context.Background(), repo.owner, repo.repo, foundSha)
+				resTag, _, err := repo.client.Git.GetTag(context.Background(), repo.tagOwner, repo.tagRepo, foundSha)
 				if err != nil {
 					continue
 				}
@@ -186,11 +906,20 @@ func (repo *GitHubRepository) GetReleases(rawRe string) ([]*semrel.Release, erro
 				}
 				foundSha = resTag.Object.GetSHA()
 			}
-			version, err := semver.NewVersion(tag)
+			version, err := repo.parseTagVersion(tag)
 			if err != nil {
 				continue
 			}
-			allReleases = append(allReleases, &semrel.Release{SHA: foundSha, Version: version.String()})
+			if repo.releasesBranch != "" {
+				reachable, err := repo.isReachableFrom(foundSha, repo.releasesBranch, reachableCache)
+				if err != nil {
+					return nil, err
+				}
+				if !reachable {
+					continue
+				}
+			}
+			allReleases = append(allReleases, &taggedRelease{tag: tag, release: &semrel.Release{SHA: foundSha, Version: version.String()}})
 		}
 		if resp.NextPage == 0 {
 			break
@@ -198,39 +927,297 @@ func (repo *GitHubRepository) GetReleases(rawRe string) ([]*semrel.Release, erro
 		opts.Page = resp.NextPage
 	}
 
-	return allReleases, nil
+	if repo.releasesWithoutTags {
+		releasesWithoutTags, err := repo.resolveReleasesWithoutTags(githubReleases, seenTags, reachableCache, re, rawRe)
+		if err != nil {
+			return nil, err
+		}
+		allReleases = append(allReleases, releasesWithoutTags...)
+	}
+
+	return repo.dedupeReleases(allReleases), nil
 }
 
 func (repo *GitHubRepository) CreateRelease(release *provider.CreateReleaseConfig) error {
-	prefix := "v"
-	if repo.stripVTagPrefix {
-		prefix = ""
+	if repo.metricsPushgateway != "" {
+		defer repo.pushMetrics()
+	}
+	if release.SHA == "" {
+		release.SHA = repo.defaultSHA
 	}
+	if release.Branch == "" {
+		release.Branch = repo.defaultBranch
+	}
+	err := repo.createRelease(release)
+	if err != nil && repo.failureIssue {
+		repo.reportReleaseFailure(repo.formatTag(release.NewVersion), err)
+	}
+	return classifyError(err)
+}
 
-	tag := prefix + release.NewVersion
+func (repo *GitHubRepository) createRelease(release *provider.CreateReleaseConfig) error {
+	if repo.releaseLock {
+		unlock, err := repo.acquireReleaseLock(release.Branch, release.SHA)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
+
+	tag := repo.formatTag(release.NewVersion)
 	isPrerelease := release.Prerelease || semver.MustParse(release.NewVersion).Prerelease() != ""
+	draft := repo.releaseDraft
 
-	if release.Branch != release.SHA {
-		ref := "refs/tags/" + tag
-		tagOpts := &github.Reference{
-			Ref:    &ref,
-			Object: &github.GitObject{SHA: &release.SHA},
+	if len(repo.dependabotGate) > 0 {
+		if err := repo.checkDependabotAlerts(&isPrerelease, &draft); err != nil {
+			return err
 		}
-		_, _, err := repo.client.Git.CreateRef(context.Background(), repo.owner, repo.repo, tagOpts)
+	}
+	if len(repo.codeScanningGate) > 0 {
+		if err := repo.checkCodeScanningAlerts(release.Branch); err != nil {
+			return err
+		}
+	}
+	if repo.secretScanningGate {
+		if err := repo.checkSecretScanningAlerts(&isPrerelease, &draft); err != nil {
+			return err
+		}
+	}
+
+	fileAssets, err := repo.collectAssets(release.NewVersion, tag)
+	if err != nil {
+		return err
+	}
+	defer removeTemporaryAssets(fileAssets)
+	sbomAssets, err := repo.collectSBOMs(release.NewVersion, tag, fileAssets)
+	if err != nil {
+		return err
+	}
+	fileAssets = append(fileAssets, sbomAssets...)
+
+	if repo.approvalEnvironment != "" {
+		if err := repo.waitForApproval(tag, release.SHA); err != nil {
+			return err
+		}
+	}
+
+	fullBody, err := repo.releaseBody(release, tag)
+	if err != nil {
+		return err
+	}
+	body, err := repo.limitReleaseBody(release.NewVersion, tag, fullBody)
+	if err != nil {
+		return err
+	}
+
+	// a previous run might have already created the release, in this case it is updated instead
+	existingRelease, err := repo.findExistingRelease(tag)
+	if err != nil {
+		return fmt.Errorf("failed to check for an existing release: %w", err)
+	}
+
+	// a prerelease of the same commit is republished as the stable release
+	var promotedRelease *github.RepositoryRelease
+	if existingRelease == nil && repo.promotePrereleases && !isPrerelease {
+		promotedRelease, err = repo.findPromotablePrerelease(release.NewVersion, release.SHA)
 		if err != nil {
 			return err
 		}
 	}
+	if promotedRelease != nil && !strings.Contains(fullBody, promotedRelease.GetBody()) {
+		fullBody = appendSection(fullBody, promotedRelease.GetBody())
+		body, err = repo.limitReleaseBody(release.NewVersion, tag, fullBody)
+		if err != nil {
+			return err
+		}
+	}
+
+	if existingRelease == nil && repo.forkRelease {
+		if err := repo.checkForkNetwork(); err != nil {
+			return err
+		}
+	}
+	if existingRelease == nil && release.Branch != release.SHA {
+		if err := repo.verifyReleaseSHA(release.SHA, release.Branch); err != nil {
+			return err
+		}
+		if err := repo.checkBranchHead(release.SHA, release.Branch); err != nil {
+			return err
+		}
+		if err := repo.checkTagRules(tag); err != nil {
+			return err
+		}
+		if len(repo.versionFiles) > 0 {
+			// the tag and all following steps refer to the commit containing the new version
+			release.SHA, err = repo.commitVersionFiles(release.NewVersion, tag, release.Branch, release.SHA)
+			if err != nil {
+				return err
+			}
+		}
+		if err := repo.createTag(tag, release.SHA); err != nil {
+			return err
+		}
+	}
+
+	makeLatest, err := repo.makeLatest(release.NewVersion)
+	if err != nil {
+		return fmt.Errorf("failed to compare with existing releases: %w", err)
+	}
+	name, err := repo.releaseName(newReleaseNameData(release.NewVersion, tag, release.Branch))
+	if err != nil {
+		return err
+	}
 
+	// with atomic releases the release is only published after all assets have been uploaded
+	isDraft := draft || repo.atomicRelease
 	opts := &github.RepositoryRelease{
 		TagName:         &tag,
-		Name:            &tag,
+		Name:            &name,
 		TargetCommitish: &release.Branch,
-		Body:            &release.Changelog,
+		Body:            &body,
 		Prerelease:      &isPrerelease,
+		Draft:           &isDraft,
+		MakeLatest:      makeLatest,
+	}
+	switch {
+	case repo.forkRelease:
+		// the branch only exists in the fork, but the commit is known to the whole fork network
+		opts.TargetCommitish = &release.SHA
+	case repo.releaseOwner != repo.owner || repo.releaseRepo != repo.repo:
+		// the released commit does not exist in the release repository, GitHub creates the tag of the release from
+		// its default branch
+		opts.TargetCommitish = nil
+	}
+	if promotedRelease != nil {
+		existingRelease = promotedRelease
+	}
+	var createdRelease *github.RepositoryRelease
+	if existingRelease != nil {
+		createdRelease, _, err = repo.client.Repositories.EditRelease(context.Background(), repo.releaseOwner, repo.releaseRepo, existingRelease.GetID(), opts)
+		if err != nil {
+			return fmt.Errorf("failed to update existing release: %w", err)
+		}
+	} else {
+		opts.GenerateReleaseNotes = github.Bool(repo.generateReleaseNotes == generateReleaseNotesServer)
+		createdRelease, _, err = repo.client.Repositories.CreateRelease(context.Background(), repo.releaseOwner, repo.releaseRepo, opts)
+		if err != nil {
+			return err
+		}
+	}
+	if body != fullBody && repo.releaseBodyOverflow == releaseBodyOverflowAsset {
+		_, err = repo.uploadReleaseAsset(createdRelease.GetID(), fullChangelogAssetName, strings.NewReader(fullBody), int64(len(fullBody)))
+		if err != nil {
+			return err
+		}
+	}
+	err = repo.uploadAssets(release, tag, createdRelease.GetID(), fileAssets)
+	if err != nil {
+		return err
+	}
+	if repo.badge {
+		// drafts are not the latest release until they are published manually
+		latest := makeLatest == nil && !draft
+		if err := repo.publishBadge(createdRelease.GetID(), tag, release.Branch, isPrerelease, latest); err != nil {
+			return err
+		}
+	}
+	if draft {
+		return repo.releaseCreated(release.NewVersion, tag, body, createdRelease)
+	}
+	if repo.atomicRelease {
+		// the URL of the published release differs from the URL of the draft
+		createdRelease, _, err = repo.client.Repositories.EditRelease(context.Background(), repo.releaseOwner, repo.releaseRepo, createdRelease.GetID(), &github.RepositoryRelease{
+			Draft: github.Bool(false),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to publish release: %w", err)
+		}
+	}
+	if err := repo.releaseCreated(release.NewVersion, tag, body, createdRelease); err != nil {
+		return err
+	}
+	if err := repo.updateAliasTags(release.NewVersion, release.SHA); err != nil {
+		return err
+	}
+	if err := repo.cleanupSupersededPrereleases(release.NewVersion); err != nil {
+		return err
+	}
+	if repo.markSuperseded {
+		if err := repo.markPreviousReleaseSuperseded(release.NewVersion, tag); err != nil {
+			return err
+		}
+	}
+	if len(repo.releasedLabels) > 0 || len(repo.releasedLabelsRemove) > 0 {
+		if err := repo.labelReleasedItems(release.NewVersion, tag, release.Branch, release.SHA); err != nil {
+			return err
+		}
+	}
+	if repo.milestones {
+		if err := repo.closeReleaseMilestone(newReleaseNameData(release.NewVersion, tag, release.Branch), release.SHA); err != nil {
+			return err
+		}
+	}
+	if repo.announcementCategory != "" {
+		if err := repo.postAnnouncement(newReleaseNameData(release.NewVersion, tag, release.Branch), body, createdRelease.GetHTMLURL()); err != nil {
+			return err
+		}
+	}
+	if repo.webhookURL != "" {
+		if err := repo.sendReleaseWebhook(release.NewVersion, tag, release.Changelog, createdRelease); err != nil {
+			return err
+		}
+	}
+	if repo.trackingIssue {
+		if err := repo.updateTrackingIssue(tag, body); err != nil {
+			return err
+		}
+	}
+	if len(repo.mirrorRepos) > 0 {
+		if err := repo.mirrorRelease(tag, release.SHA, createdRelease); err != nil {
+			return err
+		}
+	}
+	switch repo.changelogUpdate {
+	case changelogUpdatePR:
+		if err := repo.openChangelogPullRequest(tag, release.Branch, release.Changelog); err != nil {
+			return err
+		}
+	case changelogUpdateCommit:
+		if err := repo.commitChangelogToBranch(tag, release.Branch, release.Changelog); err != nil {
+			return err
+		}
+	}
+	if repo.maintenanceBranches {
+		if err := repo.createMaintenanceBranch(release.NewVersion); err != nil {
+			return err
+		}
+	}
+	if repo.mergeBackBranch != "" {
+		if err := repo.mergeBack(tag, release.Branch, release.SHA); err != nil {
+			return err
+		}
+	}
+	if repo.commitStatusContext != "" {
+		if err := repo.createCommitStatus(release.SHA, tag, createdRelease.GetHTMLURL()); err != nil {
+			return err
+		}
+	}
+	if repo.deploymentEnvironment != "" {
+		return repo.createDeployment(tag, createdRelease.GetHTMLURL())
+	}
+	return nil
+}
+
+// splitList splits a comma separated option value and drops empty entries.
+func splitList(value string) []string {
+	list := make([]string, 0)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry != "" {
+			list = append(list, entry)
+		}
 	}
-	_, _, err := repo.client.Repositories.CreateRelease(context.Background(), repo.owner, repo.repo, opts)
-	return err
+	return list
 }
 
 func (repo *GitHubRepository) Name() string {
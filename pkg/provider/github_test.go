@@ -1,12 +1,18 @@
 package provider
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -158,6 +164,14 @@ func githubHandler(w http.ResponseWriter, r *http.Request) {
 		json.NewEncoder(w).Encode(githubCommits[skip:])
 		return
 	}
+	if r.Method == http.MethodGet && r.URL.Path == "/repos/owner/test-repo/commits/"+testSHA {
+		fmt.Fprint(w, testSHA)
+		return
+	}
+	if r.Method == http.MethodGet && r.URL.Path == "/repos/owner/test-repo/rulesets" {
+		fmt.Fprint(w, "[]")
+		return
+	}
 	if r.Method == http.MethodGet && r.URL.Path == "/repos/owner/test-repo/git/matching-refs/tags" {
 		json.NewEncoder(w).Encode(githubTags)
 		return
@@ -186,6 +200,14 @@ func githubHandler(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprint(w, "{}")
 		return
 	}
+	if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/repos/owner/test-repo/releases/tags/") {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	if r.Method == http.MethodGet && r.URL.Path == "/repos/owner/test-repo/releases" {
+		fmt.Fprint(w, "[]")
+		return
+	}
 	if r.Method == http.MethodGet && r.URL.Path == "/repos/owner/test-repo/git/tags/12345678" {
 		sha := testSHA
 		json.NewEncoder(w).Encode(github.Tag{
@@ -197,6 +219,8 @@ func githubHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func getNewGithubTestRepo(t *testing.T) (*GitHubRepository, *httptest.Server) {
+	// the tests must not pick up the defaults of the workflow run when running in GitHub Actions
+	t.Setenv("GITHUB_ACTIONS", "")
 	repo := &GitHubRepository{}
 	err := repo.Init(map[string]string{
 		"slug":  "owner/test-repo",
@@ -208,6 +232,93 @@ func getNewGithubTestRepo(t *testing.T) (*GitHubRepository, *httptest.Server) {
 	return repo, ts
 }
 
+var assetUploadPath = regexp.MustCompile(`^/repos/owner/test-repo/releases/\d+/assets$`)
+
+// githubRecorder records the created releases and uploaded assets and allows overriding single routes of githubHandler.
+type githubRecorder struct {
+	mu       sync.Mutex
+	assets   map[string][]byte
+	releases []*github.RepositoryRelease
+	routes   map[string]http.HandlerFunc
+}
+
+func (rec *githubRecorder) get(name string) ([]byte, bool) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	data, ok := rec.assets[name]
+	return data, ok
+}
+
+func (rec *githubRecorder) lastRelease() *github.RepositoryRelease {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if len(rec.releases) == 0 {
+		return nil
+	}
+	return rec.releases[len(rec.releases)-1]
+}
+
+// handle registers a handler for the given route, e.g. "GET /repos/owner/test-repo/releases".
+func (rec *githubRecorder) handle(route string, handler http.HandlerFunc) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.routes[route] = handler
+}
+
+func (rec *githubRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rec.mu.Lock()
+	handler, ok := rec.routes[r.Method+" "+r.URL.Path]
+	rec.mu.Unlock()
+	if ok {
+		handler(w, r)
+		return
+	}
+	if r.Method == http.MethodPost && assetUploadPath.MatchString(r.URL.Path) {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		rec.mu.Lock()
+		rec.assets[r.URL.Query().Get("name")] = data
+		rec.mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, "{}")
+		return
+	}
+	if r.Method == http.MethodPost && r.URL.Path == "/repos/owner/test-repo/releases" {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		release := &github.RepositoryRelease{}
+		if err := json.Unmarshal(data, release); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		rec.mu.Lock()
+		rec.releases = append(rec.releases, release)
+		rec.mu.Unlock()
+		r.Body = io.NopCloser(bytes.NewReader(data))
+	}
+	githubHandler(w, r)
+}
+
+func getNewGithubRecordingTestRepo(t *testing.T, config map[string]string) (*GitHubRepository, *httptest.Server, *githubRecorder) {
+	t.Setenv("GITHUB_ACTIONS", "")
+	rec := &githubRecorder{assets: map[string][]byte{}, routes: map[string]http.HandlerFunc{}}
+	ts := httptest.NewServer(rec)
+	repo := &GitHubRepository{}
+	config["slug"] = "owner/test-repo"
+	config["token"] = "token"
+	err := repo.Init(config)
+	require.NoError(t, err)
+	repo.client.BaseURL, _ = url.Parse(ts.URL + "/")
+	repo.client.UploadURL, _ = url.Parse(ts.URL + "/")
+	return repo, ts, rec
+}
+
 func TestGithubGetInfo(t *testing.T) {
 	repo, ts := getNewGithubTestRepo(t)
 	defer ts.Close()
@@ -219,6 +330,18 @@ func TestGithubGetInfo(t *testing.T) {
 	require.True(t, repoInfo.Private)
 }
 
+func TestGithubGetInfoArchived(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo", func(w http.ResponseWriter, _ *http.Request) {
+		archivedRepo := githubRepo
+		archivedRepo.Archived = github.Bool(true)
+		_ = json.NewEncoder(w).Encode(archivedRepo)
+	})
+	_, err := repo.GetInfo()
+	require.ErrorContains(t, err, "repository owner/test-repo is archived")
+}
+
 func TestGithubGetCommits(t *testing.T) {
 	repo, ts := getNewGithubTestRepo(t)
 	defer ts.Close()
@@ -238,6 +361,7 @@ func TestGithubGetCommits(t *testing.T) {
 		require.Equal(t, c.Annotations["committer_email"], githubCommits[idxOff].Commit.GetCommitter().GetEmail())
 		require.Equal(t, c.Annotations["author_date"], githubCommits[idxOff].Commit.GetAuthor().GetDate().Format(time.RFC3339))
 		require.Equal(t, c.Annotations["committer_date"], githubCommits[idxOff].Commit.GetCommitter().GetDate().Format(time.RFC3339))
+		require.Equal(t, c.Annotations["commit_url"], repo.repoURL()+"/commit/"+githubCommits[idxOff].GetSHA())
 	}
 }
 
@@ -285,6 +409,24 @@ func TestGithubGetReleases(t *testing.T) {
 	}
 }
 
+func TestGithubEmptyRepository(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+
+	emptyRepository := func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message":"Git Repository is empty."}`, http.StatusConflict)
+	}
+	rec.handle("GET /repos/owner/test-repo/commits", emptyRepository)
+	rec.handle("GET /repos/owner/test-repo/git/matching-refs/tags", emptyRepository)
+
+	commits, err := repo.GetCommits("", "master")
+	require.NoError(t, err)
+	require.Empty(t, commits)
+	releases, err := repo.GetReleases("")
+	require.NoError(t, err)
+	require.Empty(t, releases)
+}
+
 func TestGithubCreateRelease(t *testing.T) {
 	repo, ts := getNewGithubTestRepo(t)
 	defer ts.Close()
@@ -292,6 +434,41 @@ func TestGithubCreateRelease(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGithubCreateDraftRelease(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_release_draft": "true",
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.True(t, rec.lastRelease().GetDraft())
+}
+
+func TestGithubCreateAtomicRelease(t *testing.T) {
+	checksumsFile := filepath.Join(t.TempDir(), "checksums.txt")
+	require.NoError(t, os.WriteFile(checksumsFile, []byte("checksums"), 0o600))
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_atomic_release": "true",
+		"github_checksums_file": checksumsFile,
+	})
+	defer ts.Close()
+
+	published := false
+	rec.handle("PATCH /repos/owner/test-repo/releases/0", func(w http.ResponseWriter, r *http.Request) {
+		_, uploaded := rec.get("checksums.txt")
+		require.True(t, uploaded)
+		data := &github.RepositoryRelease{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(data))
+		published = !data.GetDraft()
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.True(t, rec.lastRelease().GetDraft())
+	require.True(t, published)
+}
+
 func TestGitHubStripVTagRelease(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(githubHandler))
 	defer ts.Close()
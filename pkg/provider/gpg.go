@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+)
+
+func loadGPGEntity(armoredKey, passphrase string) (*openpgp.Entity, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armoredKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gpg private key: %w", err)
+	}
+	if len(entities) == 0 {
+		return nil, errors.New("no gpg key found")
+	}
+	entity := entities[0]
+	if entity.PrivateKey == nil {
+		return nil, errors.New("gpg key is not a private key")
+	}
+	if passphrase != "" {
+		if err := entity.DecryptPrivateKeys([]byte(passphrase)); err != nil {
+			return nil, fmt.Errorf("failed to decrypt gpg private key: %w", err)
+		}
+	}
+	if entity.PrivateKey.Encrypted {
+		return nil, errors.New("gpg private key is encrypted but no passphrase was provided")
+	}
+	return entity, nil
+}
+
+func gpgDetachSign(entity *openpgp.Entity, data []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := openpgp.ArmoredDetachSign(buf, entity, bytes.NewReader(data), nil); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestGPGKey(t *testing.T, passphrase string) (string, *openpgp.Entity) {
+	entity, err := openpgp.NewEntity("semantic-release", "", "semrel@example.com", &packet.Config{Algorithm: packet.PubKeyAlgoEdDSA})
+	require.NoError(t, err)
+	if passphrase != "" {
+		require.NoError(t, entity.EncryptPrivateKeys([]byte(passphrase), nil))
+	}
+	buf := &bytes.Buffer{}
+	w, err := armor.Encode(buf, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.SerializePrivateWithoutSigning(w, nil))
+	require.NoError(t, w.Close())
+	return buf.String(), entity
+}
+
+func TestGPGDetachSign(t *testing.T) {
+	key, publicEntity := createTestGPGKey(t, "secret")
+
+	_, err := loadGPGEntity(key, "")
+	require.ErrorContains(t, err, "no passphrase")
+	_, err = loadGPGEntity(key, "wrong")
+	require.ErrorContains(t, err, "failed to decrypt")
+
+	entity, err := loadGPGEntity(key, "secret")
+	require.NoError(t, err)
+
+	data := []byte("deadbeef  provider-github_linux_amd64\n")
+	sig, err := gpgDetachSign(entity, data)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(sig), "-----BEGIN PGP SIGNATURE-----"))
+
+	_, err = openpgp.CheckArmoredDetachedSignature(openpgp.EntityList{publicEntity}, bytes.NewReader(data), bytes.NewReader(sig), nil)
+	require.NoError(t, err)
+}
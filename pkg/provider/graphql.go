@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// graphQL executes the query and decodes the data of the response into data. The endpoint is resolved relative to
+// the REST API URL, which maps /api/v3/ to /api/graphql on GitHub Enterprise Server and / to /graphql on github.com.
+func (repo *GitHubRepository) graphQL(query string, variables map[string]interface{}, data interface{}) error {
+	req, err := repo.client.NewRequest(http.MethodPost, "../graphql", &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return err
+	}
+	var resp graphQLResponse
+	if _, err := repo.client.Do(context.Background(), req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		messages := make([]string, 0, len(resp.Errors))
+		for _, e := range resp.Errors {
+			messages = append(messages, e.Message)
+		}
+		return errors.New(strings.Join(messages, "; "))
+	}
+	return json.Unmarshal(resp.Data, data)
+}
+
+const repositoryInfoQuery = `query($owner: String!, $name: String!) {
+  repository(owner: $owner, name: $name) {
+    name
+    owner { login }
+    defaultBranchRef { name }
+    isPrivate
+    visibility
+    isArchived
+    isFork
+    parent { nameWithOwner }
+    licenseInfo { spdxId }
+    repositoryTopics(first: 100) { nodes { topic { name } } }
+    viewerPermission
+  }
+}`
+
+type repositoryInfoResult struct {
+	Repository *struct {
+		Name  string
+		Owner struct {
+			Login string
+		}
+		DefaultBranchRef *struct {
+			Name string
+		}
+		IsPrivate  bool
+		Visibility string
+		IsArchived bool
+		IsFork     bool
+		Parent     *struct {
+			NameWithOwner string
+		}
+		LicenseInfo *struct {
+			SpdxID string `json:"spdxId"`
+		}
+		RepositoryTopics struct {
+			Nodes []struct {
+				Topic struct {
+					Name string
+				}
+			}
+		}
+		ViewerPermission string
+	}
+}
+
+// getRepositoryGraphQL fetches the repository information with a single GraphQL query and converts it to the
+// REST representation, so that it can be used interchangeably with Repositories.Get.
+func (repo *GitHubRepository) getRepositoryGraphQL() (*github.Repository, error) {
+	var result repositoryInfoResult
+	err := repo.graphQL(repositoryInfoQuery, map[string]interface{}{"owner": repo.owner, "name": repo.repo}, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query repository: %w", err)
+	}
+	r := result.Repository
+	if r == nil {
+		return nil, fmt.Errorf("repository %s/%s not found", repo.owner, repo.repo)
+	}
+	topics := make([]string, 0, len(r.RepositoryTopics.Nodes))
+	for _, node := range r.RepositoryTopics.Nodes {
+		topics = append(topics, node.Topic.Name)
+	}
+	ghRepo := &github.Repository{
+		Name:       github.String(r.Name),
+		Owner:      &github.User{Login: github.String(r.Owner.Login)},
+		Private:    github.Bool(r.IsPrivate),
+		Visibility: github.String(strings.ToLower(r.Visibility)),
+		Archived:   github.Bool(r.IsArchived),
+		Fork:       github.Bool(r.IsFork),
+		Topics:     topics,
+	}
+	// the default branch of an empty repository does not exist yet
+	if r.DefaultBranchRef != nil {
+		ghRepo.DefaultBranch = github.String(r.DefaultBranchRef.Name)
+	}
+	if r.Parent != nil {
+		ghRepo.Parent = &github.Repository{FullName: github.String(r.Parent.NameWithOwner)}
+	}
+	if permissions, ok := viewerPermissions[r.ViewerPermission]; ok {
+		ghRepo.Permissions = make(map[string]bool)
+		for _, permission := range permissions {
+			ghRepo.Permissions[permission] = true
+		}
+	}
+	if r.LicenseInfo != nil {
+		ghRepo.License = &github.License{SPDXID: github.String(r.LicenseInfo.SpdxID)}
+	}
+	return ghRepo, nil
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubGetInfoGraphQL(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_use_graphql": "true"})
+	defer ts.Close()
+
+	var request graphQLRequest
+	rec.handle("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		fmt.Fprint(w, `{"data":{"repository":{
+			"name":"test-repo",
+			"owner":{"login":"owner"},
+			"defaultBranchRef":{"name":"master"},
+			"isPrivate":true,
+			"visibility":"INTERNAL",
+			"isArchived":false,
+			"isFork":true,
+			"parent":{"nameWithOwner":"upstream/test-repo"},
+			"licenseInfo":{"spdxId":"MIT"},
+			"repositoryTopics":{"nodes":[{"topic":{"name":"go"}}]}
+		}}}`)
+	})
+	repoInfo, err := repo.GetInfo()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"owner": "owner", "name": "test-repo"}, request.Variables)
+	require.Equal(t, githubDefaultBranch, repoInfo.DefaultBranch)
+	require.Equal(t, githubOwnerLogin, repoInfo.Owner)
+	require.Equal(t, githubRepoName, repoInfo.Repo)
+	require.True(t, repoInfo.Private)
+	require.Equal(t, map[string]string{
+		"repo_topics":     "go",
+		"repo_license":    "MIT",
+		"repo_fork":       "true",
+		"repo_parent":     "upstream/test-repo",
+		"repo_visibility": "internal",
+	}, repo.RepositoryAnnotations())
+}
+
+func TestGithubGetInfoGraphQLErrors(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_use_graphql": "true"})
+	defer ts.Close()
+
+	rec.handle("POST /graphql", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data":{"repository":{"name":"test-repo","owner":{"login":"owner"},"isArchived":true}}}`)
+	})
+	_, err := repo.GetInfo()
+	require.ErrorContains(t, err, "is archived")
+
+	rec.handle("POST /graphql", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data":{"repository":null},"errors":[{"message":"Could not resolve to a Repository"}]}`)
+	})
+	_, err = repo.GetInfo()
+	require.ErrorContains(t, err, "failed to query repository: Could not resolve to a Repository")
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// compileAuthorPatterns converts the author patterns to case-insensitive regular expressions, only * is a wildcard
+// so that logins like dependabot[bot] can be used as is.
+func compileAuthorPatterns(patterns []string) []*regexp.Regexp {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		parts := strings.Split(pattern, "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		compiled = append(compiled, regexp.MustCompile("(?i)^"+strings.Join(parts, ".*")+"$"))
+	}
+	return compiled
+}
+
+// isIgnoredAuthor reports whether the login of the commit author matches one of the patterns.
+func isIgnoredAuthor(patterns []*regexp.Regexp, commit *github.RepositoryCommit) bool {
+	login := commit.GetAuthor().GetLogin()
+	if login == "" {
+		return false
+	}
+	for _, pattern := range patterns {
+		if pattern.MatchString(login) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func createGithubCommitWithAuthor(sha, message, login string) *github.RepositoryCommit {
+	commit := createGithubCommit(sha, message)
+	commit.Author = &github.User{Login: github.String(login)}
+	return commit
+}
+
+func TestIsIgnoredAuthor(t *testing.T) {
+	patterns := compileAuthorPatterns([]string{"dependabot[bot]", "*-bot"})
+	require.True(t, isIgnoredAuthor(patterns, createGithubCommitWithAuthor("a", "", "Dependabot[bot]")))
+	require.True(t, isIgnoredAuthor(patterns, createGithubCommitWithAuthor("a", "", "release-bot")))
+	require.False(t, isIgnoredAuthor(patterns, createGithubCommitWithAuthor("a", "", "alice")))
+	require.False(t, isIgnoredAuthor(patterns, &github.RepositoryCommit{}))
+}
+
+func TestGithubGetCommitsIgnoreAuthors(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"ignore_authors": "dependabot[bot], renovate[bot]",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/commits", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]*github.RepositoryCommit{
+			createGithubCommitWithAuthor("c3", "fix(deps): bump x", "renovate[bot]"),
+			createGithubCommitWithAuthor("c2", "feat: feature", "alice"),
+			createGithubCommitWithAuthor("c1", "fix(deps): bump y", "dependabot[bot]"),
+		})
+	})
+
+	commits, err := repo.GetCommits("", "c3")
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	require.Equal(t, "c2", commits[0].SHA)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// maxAssetSize is the exclusive size limit of release assets, GitHub rejects larger files during the upload.
+var maxAssetSize int64 = 2 << 30
+
+// splitAsset splits the asset into parts below the size limit named <asset>.001, <asset>.002, ..., which can be
+// joined with cat.
+func splitAsset(asset *releaseAsset, size int64) ([]*releaseAsset, error) {
+	dir, err := os.MkdirTemp("", "semrel-parts-")
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(asset.path)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("failed to read asset %s: %w", asset.path, err)
+	}
+	defer f.Close()
+	partSize := maxAssetSize - 1
+	parts := make([]*releaseAsset, 0, size/partSize+1)
+	for i := 1; int64(i-1)*partSize < size; i++ {
+		name := fmt.Sprintf("%s.%03d", asset.name, i)
+		part := &releaseAsset{name: name, path: filepath.Join(dir, name)}
+		out, err := os.Create(part.path)
+		if err == nil {
+			_, err = io.CopyN(out, f, partSize)
+			if err == io.EOF {
+				err = nil
+			}
+			if closeErr := out.Close(); err == nil {
+				err = closeErr
+			}
+		}
+		if err != nil {
+			os.RemoveAll(dir)
+			return nil, fmt.Errorf("failed to split asset %s: %w", asset.name, err)
+		}
+		parts = append(parts, part)
+	}
+	// the parts share the temporary directory, it is removed with the first part
+	parts[0].tempDir = dir
+	return parts, nil
+}
+
+// checkAssetSizes fails on assets that exceed the size limit of GitHub, instead of a failing upload after the release
+// has been created. If github_split_large_assets is set they are split into multiple parts instead.
+func (repo *GitHubRepository) checkAssetSizes(assets []*releaseAsset) ([]*releaseAsset, error) {
+	checked := make([]*releaseAsset, 0, len(assets))
+	for _, asset := range assets {
+		info, err := os.Stat(asset.path)
+		if err != nil {
+			removeTemporaryAssets(checked)
+			return nil, fmt.Errorf("failed to read asset %s: %w", asset.path, err)
+		}
+		if info.Size() < maxAssetSize {
+			checked = append(checked, asset)
+			continue
+		}
+		if !repo.splitLargeAssets {
+			removeTemporaryAssets(checked)
+			return nil, fmt.Errorf("asset %s (%d bytes) exceeds the GitHub limit of %d bytes per release asset, "+
+				"split it or set github_split_large_assets", asset.name, info.Size(), maxAssetSize-1)
+		}
+		parts, err := splitAsset(asset, info.Size())
+		if err != nil {
+			removeTemporaryAssets(checked)
+			return nil, err
+		}
+		removeTemporaryAssets([]*releaseAsset{asset})
+		checked = append(checked, parts...)
+	}
+	return checked, nil
+}
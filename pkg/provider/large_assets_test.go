@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func setMaxAssetSize(t *testing.T, size int64) {
+	previous := maxAssetSize
+	maxAssetSize = size
+	t.Cleanup(func() { maxAssetSize = previous })
+}
+
+func TestGithubLargeAssetFails(t *testing.T) {
+	setMaxAssetSize(t, 4)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app"), []byte("0123456789"), 0o600))
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_assets": filepath.Join(dir, "app")})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.ErrorContains(t, err, "asset app (10 bytes) exceeds the GitHub limit of 3 bytes per release asset")
+	require.Nil(t, rec.lastRelease())
+}
+
+func TestGithubSplitLargeAssets(t *testing.T) {
+	setMaxAssetSize(t, 4)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app"), []byte("0123456789"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "small"), []byte("012"), 0o600))
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_assets":             filepath.Join(dir, "*"),
+		"github_split_large_assets": "true",
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	for name, content := range map[string]string{"app.001": "012", "app.002": "345", "app.003": "678", "app.004": "9", "small": "012"} {
+		data, ok := rec.get(name)
+		require.True(t, ok, name)
+		require.Equal(t, content, string(data))
+	}
+	_, ok := rec.get("app")
+	require.False(t, ok)
+	_, ok = rec.get("app.005")
+	require.False(t, ok)
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-github/v66/github"
+)
+
+// makeLatest returns "false" if a higher stable version than version already exists, e.g. when releasing a patch
+// from a maintenance branch, so that the release does not become the latest release of the repository.
+func (repo *GitHubRepository) makeLatest(version string) (*string, error) {
+	current, err := semver.NewVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	if current.Prerelease() != "" {
+		return nil, nil
+	}
+	tags, err := repo.listTags()
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		v, err := repo.parseTagVersion(tag)
+		if err != nil || v.Prerelease() != "" {
+			continue
+		}
+		if v.GreaterThan(current) {
+			return github.String("false"), nil
+		}
+	}
+	return nil, nil
+}
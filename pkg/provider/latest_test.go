@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubMakeLatest(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+
+	validTags["v1.5.0"] = true
+	defer delete(validTags, "v1.5.0")
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "1.5.0", SHA: testSHA, Branch: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, "false", rec.lastRelease().GetMakeLatest())
+
+	for _, version := range []string{"2021.0.0", "3.0.0-beta.3"} {
+		makeLatest, err := repo.makeLatest(version)
+		require.NoError(t, err)
+		require.Nil(t, makeLatest)
+	}
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/go-github/v66/github"
+)
+
+const defaultReleaseLockTTL = 10 * time.Minute
+
+const repositoryIDQuery = `query($owner: String!, $name: String!) {
+  repository(owner: $owner, name: $name) { id }
+}`
+
+// updateLockRefMutation only updates the ref if it still points to beforeOid.
+const updateLockRefMutation = `mutation($repositoryId: ID!, $name: GitRefname!, $beforeOid: GitObjectID!, $afterOid: GitObjectID!) {
+  updateRefs(input: {repositoryId: $repositoryId, refUpdates: [{name: $name, beforeOid: $beforeOid, afterOid: $afterOid, force: true}]}) {
+    clientMutationId
+  }
+}`
+
+type repositoryIDResult struct {
+	Repository *struct {
+		ID string
+	}
+}
+
+func releaseLockRef(branch string) string {
+	return "semrel-lock/" + branch
+}
+
+// createLockObject creates a tag object for sha, its tagger date records when the lock was acquired.
+func (repo *GitHubRepository) createLockObject(branch, sha string) (string, error) {
+	tagObject := &github.Tag{
+		Tag:     github.String("semrel-lock"),
+		Message: github.String("semantic-release lock for branch " + branch + "\n"),
+		Object:  &github.GitObject{SHA: &sha, Type: github.String("commit")},
+		Tagger: &github.CommitAuthor{
+			Name:  github.String("semantic-release"),
+			Email: github.String("semantic-release@users.noreply.github.com"),
+			Date:  &github.Timestamp{Time: time.Now().UTC()},
+		},
+	}
+	createdTag, _, err := repo.client.Git.CreateTag(context.Background(), repo.owner, repo.repo, tagObject)
+	if err != nil {
+		return "", err
+	}
+	return createdTag.GetSHA(), nil
+}
+
+// acquireReleaseLock atomically creates the lock ref of branch, locks older than the configured TTL are taken over.
+// The returned function releases the lock.
+func (repo *GitHubRepository) acquireReleaseLock(branch, sha string) (func(), error) {
+	objectSHA, err := repo.createLockObject(branch, sha)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create release lock: %w", err)
+	}
+	ref := &github.Reference{
+		Ref:    github.String("refs/" + releaseLockRef(branch)),
+		Object: &github.GitObject{SHA: &objectSHA},
+	}
+	_, _, err = repo.client.Git.CreateRef(context.Background(), repo.owner, repo.repo, ref)
+	if isAlreadyExistsError(err) {
+		err = repo.takeOverStaleLock(branch, ref)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		repo.releaseReleaseLock(branch, objectSHA)
+	}, nil
+}
+
+// releaseReleaseLock deletes the lock ref if it is still held by this run, it might have been taken over by another
+// release after the TTL.
+func (repo *GitHubRepository) releaseReleaseLock(branch, objectSHA string) {
+	existingRef, _, err := repo.client.Git.GetRef(context.Background(), repo.owner, repo.repo, releaseLockRef(branch))
+	if err != nil {
+		log.Printf("warning: failed to release lock for branch %s: %v", branch, err)
+		return
+	}
+	if existingRef.GetObject().GetSHA() != objectSHA {
+		log.Printf("warning: the release lock for branch %s has been taken over by another release, it is not released", branch)
+		return
+	}
+	if _, err := repo.client.Git.DeleteRef(context.Background(), repo.owner, repo.repo, releaseLockRef(branch)); err != nil {
+		log.Printf("warning: failed to release lock for branch %s: %v", branch, err)
+	}
+}
+
+func (repo *GitHubRepository) takeOverStaleLock(branch string, ref *github.Reference) error {
+	existingRef, _, err := repo.client.Git.GetRef(context.Background(), repo.owner, repo.repo, releaseLockRef(branch))
+	if err != nil {
+		return fmt.Errorf("failed to get release lock: %w", err)
+	}
+	existingTag, _, err := repo.client.Git.GetTag(context.Background(), repo.owner, repo.repo, existingRef.GetObject().GetSHA())
+	if err != nil {
+		return fmt.Errorf("failed to get release lock: %w", err)
+	}
+	lockedAt := existingTag.GetTagger().GetDate().Time
+	if time.Since(lockedAt) < repo.releaseLockTTL {
+		return fmt.Errorf("branch %s is locked by another release since %s", branch, lockedAt.Format(time.RFC3339))
+	}
+
+	// the takeover is a compare-and-swap, so that only one of several runs that found the stale lock gets it
+	var result repositoryIDResult
+	err = repo.graphQL(repositoryIDQuery, map[string]interface{}{"owner": repo.owner, "name": repo.repo}, &result)
+	if err != nil {
+		return fmt.Errorf("failed to take over stale release lock: %w", err)
+	}
+	if result.Repository == nil {
+		return fmt.Errorf("failed to take over stale release lock: repository %s/%s not found", repo.owner, repo.repo)
+	}
+	err = repo.graphQL(updateLockRefMutation, map[string]interface{}{
+		"repositoryId": result.Repository.ID,
+		"name":         ref.GetRef(),
+		"beforeOid":    existingRef.GetObject().GetSHA(),
+		"afterOid":     ref.GetObject().GetSHA(),
+	}, &struct{}{})
+	if err != nil {
+		return fmt.Errorf("failed to take over stale release lock of branch %s, it might have been taken over by another release: %w", branch, err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubReleaseLock(t *testing.T) {
+	testCases := []struct {
+		name     string
+		held     bool
+		lockedAt time.Time
+		// raced lets the compare-and-swap of the takeover fail
+		raced bool
+		// replaced lets another release take over the lock during the release
+		replaced bool
+		errMsg   string
+	}{
+		{name: "free"},
+		{name: "held", held: true, lockedAt: time.Now().Add(-time.Minute), errMsg: "branch main is locked by another release"},
+		{name: "stale", held: true, lockedAt: time.Now().Add(-time.Hour)},
+		{name: "stale raced", held: true, lockedAt: time.Now().Add(-time.Hour), raced: true, errMsg: "might have been taken over by another release"},
+		{name: "replaced", replaced: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+				"github_release_lock": "true",
+			})
+			defer ts.Close()
+			rec.handle("POST /repos/owner/test-repo/git/tags", func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprint(w, `{"sha": "10c4"}`)
+			})
+			lockSHA := ""
+			if tc.held {
+				lockSHA = "01d"
+			}
+			tagCreated := false
+			rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, r *http.Request) {
+				var data map[string]string
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&data))
+				if data["ref"] == "refs/tags/v2.0.0" {
+					tagCreated = true
+					if tc.replaced {
+						lockSHA = "0e7"
+					}
+					fmt.Fprint(w, "{}")
+					return
+				}
+				require.Equal(t, "refs/semrel-lock/main", data["ref"])
+				require.Equal(t, "10c4", data["sha"])
+				if lockSHA != "" {
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					fmt.Fprint(w, `{"message": "Reference already exists"}`)
+					return
+				}
+				lockSHA = data["sha"]
+				fmt.Fprint(w, "{}")
+			})
+			rec.handle("GET /repos/owner/test-repo/git/ref/semrel-lock/main", func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprintf(w, `{"ref": "refs/semrel-lock/main", "object": {"type": "tag", "sha": %q}}`, lockSHA)
+			})
+			rec.handle("GET /repos/owner/test-repo/git/tags/01d", func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprintf(w, `{"sha": "01d", "tagger": {"name": "semantic-release", "date": %q}}`, tc.lockedAt.Format(time.RFC3339))
+			})
+			takenOver := false
+			rec.handle("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+				var request graphQLRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+				if request.Query == repositoryIDQuery {
+					fmt.Fprint(w, `{"data": {"repository": {"id": "R_1"}}}`)
+					return
+				}
+				require.Equal(t, map[string]interface{}{
+					"repositoryId": "R_1",
+					"name":         "refs/semrel-lock/main",
+					"beforeOid":    "01d",
+					"afterOid":     "10c4",
+				}, request.Variables)
+				if tc.raced {
+					fmt.Fprint(w, `{"data": null, "errors": [{"message": "Expected ref to point to 01d"}]}`)
+					return
+				}
+				takenOver = true
+				lockSHA = "10c4"
+				fmt.Fprint(w, `{"data": {"updateRefs": {"clientMutationId": null}}}`)
+			})
+			released := false
+			rec.handle("DELETE /repos/owner/test-repo/git/refs/semrel-lock/main", func(w http.ResponseWriter, _ *http.Request) {
+				released = true
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "main"})
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				require.False(t, tagCreated)
+				require.False(t, released)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tagCreated)
+			require.Equal(t, tc.held, takenOver)
+			// the lock of the release that took over is kept
+			require.Equal(t, !tc.replaced, released)
+		})
+	}
+}
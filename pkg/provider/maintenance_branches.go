@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-github/v66/github"
+)
+
+const defaultMaintenanceBranchFormat = "v{{.Major}}.x"
+
+// maintenanceBranchData is passed to the template of the maintenance branch names.
+type maintenanceBranchData struct {
+	Major uint64
+	Minor uint64
+}
+
+func parseMaintenanceBranchFormat(value string) (*template.Template, error) {
+	if value == "" {
+		value = defaultMaintenanceBranchFormat
+	}
+	tmpl, err := template.New("github_maintenance_branch_format").Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse github_maintenance_branch_format: %w", err)
+	}
+	return tmpl, nil
+}
+
+// createMaintenanceBranch creates a maintenance branch (e.g. v1.x) at the previous release if version is a new
+// major version, so that the previous major version can still receive patches.
+func (repo *GitHubRepository) createMaintenanceBranch(version string) error {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return err
+	}
+	if v.Prerelease() != "" {
+		return nil
+	}
+	previousTag, err := repo.findPreviousTag(version)
+	if err != nil {
+		return fmt.Errorf("failed to find previous tag: %w", err)
+	}
+	if previousTag == "" {
+		return nil
+	}
+	previous, err := repo.parseTagVersion(previousTag)
+	if err != nil {
+		return err
+	}
+	if previous.Major() == v.Major() {
+		return nil
+	}
+	var branch strings.Builder
+	if err := repo.maintenanceTemplate.Execute(&branch, maintenanceBranchData{Major: previous.Major(), Minor: previous.Minor()}); err != nil {
+		return fmt.Errorf("failed to render github_maintenance_branch_format: %w", err)
+	}
+	sha, err := repo.resolveTag(previousTag)
+	if err != nil {
+		return fmt.Errorf("failed to resolve tag %s: %w", previousTag, err)
+	}
+	_, _, err = repo.client.Git.CreateRef(context.Background(), repo.owner, repo.repo, &github.Reference{
+		Ref:    github.String("refs/heads/" + branch.String()),
+		Object: &github.GitObject{SHA: &sha},
+	})
+	// the maintenance branch might have been created manually
+	if err != nil && !isAlreadyExistsError(err) {
+		return fmt.Errorf("failed to create maintenance branch %s: %w", branch.String(), err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubMaintenanceBranch(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_maintenance_branches":      "true",
+		"github_maintenance_branch_format": "release/{{.Major}}.x",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/git/ref/tags/v1.1.1", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.Reference{
+			Ref:    github.String("refs/tags/v1.1.1"),
+			Object: &github.GitObject{SHA: github.String("1111"), Type: github.String("commit")},
+		})
+	})
+	refs := make(map[string]string)
+	rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		var data map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&data))
+		refs[data["ref"]] = data["sha"]
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"refs/tags/v2.0.0": testSHA, "refs/heads/release/1.x": "1111"}, refs)
+
+	// minor versions and prereleases do not start a new major version
+	for _, version := range []string{"1.2.0", "2.0.0-rc.1"} {
+		refs = make(map[string]string)
+		validTags["v"+version] = true
+		err = repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: version, SHA: testSHA})
+		delete(validTags, "v"+version)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"refs/tags/v" + version: testSHA}, refs)
+	}
+}
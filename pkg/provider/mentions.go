@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	mentionsEscape = "escape"
+	mentionsStrip  = "strip"
+)
+
+// mentionRe matches user and team mentions, inline code, markdown links and URLs are matched first so that they are left untouched.
+var mentionRe = regexp.MustCompile("(?m)(`[^`]*`|\\[[^\\]]*\\]\\([^)]*\\)|https?://[^\\s)>]+)" +
+	`|(^|[^\w/@])@([a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?(?:/[\w.-]+)?)`)
+
+// handleMentions escapes (wraps in backticks) or strips the @ of all mentions so that publishing the release does not notify anyone.
+func handleMentions(body, mode string) string {
+	sb := &strings.Builder{}
+	last := 0
+	for _, m := range mentionRe.FindAllStringSubmatchIndex(body, -1) {
+		sb.WriteString(body[last:m[0]])
+		last = m[1]
+		if m[2] >= 0 {
+			sb.WriteString(body[m[2]:m[3]])
+			continue
+		}
+		prefix, name := body[m[4]:m[5]], body[m[6]:m[7]]
+		if mode == mentionsStrip {
+			sb.WriteString(prefix + name)
+			continue
+		}
+		fmt.Fprintf(sb, "%s`@%s`", prefix, name)
+	}
+	sb.WriteString(body[last:])
+	return sb.String()
+}
+
+var teamRe = regexp.MustCompile(`^@?([a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?/[\w.-]+)$`)
+
+// parseNotifyTeams normalizes the teams to @org/team mentions.
+func parseNotifyTeams(value string) ([]string, error) {
+	teams := make([]string, 0)
+	for _, team := range splitList(value) {
+		m := teamRe.FindStringSubmatch(team)
+		if m == nil {
+			return nil, fmt.Errorf("invalid team in github_notify_teams: %s", team)
+		}
+		teams = append(teams, "@"+m[1])
+	}
+	return teams, nil
+}
+
+// teamMentions returns the line that notifies the teams, it is added after the other mentions have been handled.
+func teamMentions(teams []string) string {
+	if len(teams) == 0 {
+		return ""
+	}
+	return "cc " + strings.Join(teams, " ")
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleMentions(t *testing.T) {
+	testCases := []struct {
+		input    string
+		escaped  string
+		stripped string
+	}{
+		{"fix: thanks @octocat", "fix: thanks `@octocat`", "fix: thanks octocat"},
+		{"@org/team please review", "`@org/team` please review", "org/team please review"},
+		{"mail me@example.com", "mail me@example.com", "mail me@example.com"},
+		{"`@octocat` and [@octocat](https://github.com/octocat)", "`@octocat` and [@octocat](https://github.com/octocat)", "`@octocat` and [@octocat](https://github.com/octocat)"},
+		{"see https://github.com/@octocat", "see https://github.com/@octocat", "see https://github.com/@octocat"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			require.Equal(t, tc.escaped, handleMentions(tc.input, mentionsEscape))
+			require.Equal(t, tc.stripped, handleMentions(tc.input, mentionsStrip))
+		})
+	}
+}
+
+func TestParseNotifyTeams(t *testing.T) {
+	teams, err := parseNotifyTeams("my-org/maintainers, @my-org/release.team")
+	require.NoError(t, err)
+	require.Equal(t, []string{"@my-org/maintainers", "@my-org/release.team"}, teams)
+	require.Equal(t, "cc @my-org/maintainers @my-org/release.team", teamMentions(teams))
+	require.Equal(t, "", teamMentions(nil))
+
+	_, err = parseNotifyTeams("maintainers")
+	require.EqualError(t, err, "invalid team in github_notify_teams: maintainers")
+}
+
+func TestGithubNotifyTeams(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_notify_teams": "my-org/maintainers",
+		"github_mentions":     mentionsEscape,
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "thanks @octocat"})
+	require.NoError(t, err)
+	require.Equal(t, "thanks `@octocat`\n\ncc @my-org/maintainers", rec.lastRelease().GetBody())
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v66/github"
+)
+
+const (
+	mergeBackPR    = "pr"
+	mergeBackMerge = "merge"
+)
+
+// mergeBack merges the released commit back into the configured branch, e.g. develop in git-flow repositories.
+// In merge mode the commit is merged directly and a pull request is only opened if the merge conflicts.
+func (repo *GitHubRepository) mergeBack(tag, branch, sha string) error {
+	if branch == repo.mergeBackBranch {
+		return nil
+	}
+	if repo.mergeBackMode == mergeBackMerge {
+		_, resp, err := repo.client.Repositories.Merge(context.Background(), repo.owner, repo.repo, &github.RepositoryMergeRequest{
+			Base:          github.String(repo.mergeBackBranch),
+			Head:          github.String(sha),
+			CommitMessage: github.String(fmt.Sprintf("chore: merge %s into %s", tag, repo.mergeBackBranch)),
+		})
+		if resp == nil || resp.StatusCode != http.StatusConflict {
+			if err != nil {
+				return fmt.Errorf("failed to merge %s into %s: %w", tag, repo.mergeBackBranch, err)
+			}
+			return nil
+		}
+	}
+	return repo.openMergeBackPullRequest(tag, sha)
+}
+
+func (repo *GitHubRepository) openMergeBackPullRequest(tag, sha string) error {
+	// the pull request is opened from a dedicated branch so that it does not pick up later commits
+	head := "merge-back/" + tag
+	_, _, err := repo.client.Git.CreateRef(context.Background(), repo.owner, repo.repo, &github.Reference{
+		Ref:    github.String("refs/heads/" + head),
+		Object: &github.GitObject{SHA: github.String(sha)},
+	})
+	if err != nil && !isAlreadyExistsError(err) {
+		return fmt.Errorf("failed to create branch %s: %w", head, err)
+	}
+	_, _, err = repo.client.PullRequests.Create(context.Background(), repo.owner, repo.repo, &github.NewPullRequest{
+		Title: github.String(fmt.Sprintf("chore: merge %s into %s", tag, repo.mergeBackBranch)),
+		Head:  github.String(head),
+		Base:  github.String(repo.mergeBackBranch),
+		Body:  github.String(fmt.Sprintf("Merges the release %s back into `%s`.", tag, repo.mergeBackBranch)),
+	})
+	if err != nil && !isAlreadyExistsError(err) {
+		return fmt.Errorf("failed to open merge-back pull request: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func handleMergeBackPullRequest(t *testing.T, rec *githubRecorder) (*github.Reference, *github.NewPullRequest) {
+	ref := &github.Reference{}
+	pr := &github.NewPullRequest{}
+	rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		var data map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&data))
+		if data["ref"] == "refs/tags/v2.0.0" {
+			fmt.Fprint(w, "{}")
+			return
+		}
+		ref.Ref = github.String(data["ref"])
+		ref.Object = &github.GitObject{SHA: github.String(data["sha"])}
+		fmt.Fprint(w, "{}")
+	})
+	rec.handle("POST /repos/owner/test-repo/pulls", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(pr))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"PullRequest","code":"custom","message":"A pull request already exists for owner:merge-back/v2.0.0."}]}`)
+	})
+	return ref, pr
+}
+
+func TestGithubMergeBackPullRequest(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_merge_back_branch": "develop"})
+	defer ts.Close()
+	ref, pr := handleMergeBackPullRequest(t, rec)
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "master"})
+	require.NoError(t, err)
+	require.Equal(t, "refs/heads/merge-back/v2.0.0", ref.GetRef())
+	require.Equal(t, testSHA, ref.GetObject().GetSHA())
+	require.Equal(t, "merge-back/v2.0.0", pr.GetHead())
+	require.Equal(t, "develop", pr.GetBase())
+}
+
+func TestGithubMergeBackMerge(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_merge_back_branch": "develop",
+		"github_merge_back_mode":   "merge",
+	})
+	defer ts.Close()
+	ref, pr := handleMergeBackPullRequest(t, rec)
+	conflict := false
+	var merge *github.RepositoryMergeRequest
+	rec.handle("POST /repos/owner/test-repo/merges", func(w http.ResponseWriter, r *http.Request) {
+		merge = &github.RepositoryMergeRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(merge))
+		if conflict {
+			http.Error(w, `{"message":"Merge conflict"}`, http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "master"})
+	require.NoError(t, err)
+	require.Equal(t, "develop", merge.GetBase())
+	require.Equal(t, testSHA, merge.GetHead())
+	require.Nil(t, ref.Ref)
+
+	conflict = true
+	err = repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "master"})
+	require.NoError(t, err)
+	require.Equal(t, "refs/heads/merge-back/v2.0.0", ref.GetRef())
+	require.Equal(t, "develop", pr.GetBase())
+}
+
+func TestGithubMergeBackConfig(t *testing.T) {
+	repo := &GitHubRepository{}
+	err := repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_merge_back_mode": "rebase"})
+	require.EqualError(t, err, "invalid value for github_merge_back_mode: rebase")
+
+	// releases of the merge-back branch itself are not merged back
+	require.NoError(t, repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_merge_back_branch": "develop"}))
+	require.NoError(t, repo.mergeBack("v2.0.0", "develop", testSHA))
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"expvar"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var metricsClient = &http.Client{Timeout: 10 * time.Second}
+
+// latencyBuckets are the upper bounds in milliseconds of the API latency histogram.
+var latencyBuckets = []int64{100, 250, 500, 1000, 2500, 5000, 10000}
+
+// The metrics are published with expvar, so that programs embedding the provider can serve them on /debug/vars.
+var (
+	apiCalls        = expvar.NewMap("github_api_calls")
+	apiErrors       = expvar.NewMap("github_api_errors")
+	rateLimited     = expvar.NewInt("github_rate_limited")
+	paginationDepth = expvar.NewMap("github_pagination_depth")
+	apiLatency      = expvar.NewMap("github_api_latency_ms")
+	apiLatencySum   = expvar.NewInt("github_api_latency_ms_sum")
+	retries         = expvar.NewMap("github_retries")
+	waits           = expvar.NewMap("github_waits")
+	waitTime        = expvar.NewMap("github_wait_ms")
+	paginationMu    sync.Mutex
+)
+
+var endpointPatterns = []struct {
+	re          *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`^/(api/v3/)?repos/[^/]+/[^/]+`), "/repos/{owner}/{repo}"},
+	{regexp.MustCompile(`/git/(ref|refs|matching-refs)/.+$`), "/git/$1/{ref}"},
+	{regexp.MustCompile(`/contents/.+$`), "/contents/{path}"},
+	{regexp.MustCompile(`/releases/tags/.+$`), "/releases/tags/{tag}"},
+	{regexp.MustCompile(`/compare/.+$`), "/compare/{basehead}"},
+	{regexp.MustCompile(`/[0-9a-f]{40}(/|$)`), "/{sha}$1"},
+	{regexp.MustCompile(`/\d+(/|$)`), "/{id}$1"},
+}
+
+// metricsEndpoint returns the method and the path of the request with owner, repository, IDs, SHAs and refs
+// replaced by placeholders, so that the number of endpoints is bounded.
+func metricsEndpoint(r *http.Request) string {
+	path := r.URL.Path
+	for _, pattern := range endpointPatterns {
+		// repeated to replace consecutive IDs
+		for {
+			replaced := pattern.re.ReplaceAllString(path, pattern.replacement)
+			if replaced == path {
+				break
+			}
+			path = replaced
+		}
+	}
+	return r.Method + " " + path
+}
+
+func isRateLimitedResponse(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+	return resp.Header.Get("X-RateLimit-Remaining") == "0" || resp.Header.Get("Retry-After") != ""
+}
+
+// metricsTransport records the API calls by endpoint, errors by status, rate limited responses, the deepest page
+// of paginated endpoints and the latency of the requests.
+type metricsTransport struct {
+	base http.RoundTripper
+}
+
+func newMetricsTransport(base http.RoundTripper) *metricsTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &metricsTransport{base: base}
+}
+
+func (t *metricsTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	endpoint := metricsEndpoint(r)
+	start := time.Now()
+	resp, err := t.base.RoundTrip(r)
+	latency := time.Since(start).Milliseconds()
+
+	apiCalls.Add(endpoint, 1)
+	apiLatencySum.Add(latency)
+	bucket := "+Inf"
+	for _, bound := range latencyBuckets {
+		if latency <= bound {
+			bucket = strconv.FormatInt(bound, 10)
+			break
+		}
+	}
+	apiLatency.Add(bucket, 1)
+	if page, pageErr := strconv.ParseInt(r.URL.Query().Get("page"), 10, 64); pageErr == nil {
+		paginationMu.Lock()
+		if depth := paginationDepthValue(endpoint); page > depth {
+			paginationDepth.Add(endpoint, page-depth)
+		}
+		paginationMu.Unlock()
+	}
+	switch {
+	case err != nil:
+		apiErrors.Add("transport", 1)
+	case resp.StatusCode >= 400:
+		apiErrors.Add(strconv.Itoa(resp.StatusCode), 1)
+		if isRateLimitedResponse(resp) {
+			rateLimited.Add(1)
+		}
+	}
+	return resp, err
+}
+
+func paginationDepthValue(endpoint string) int64 {
+	return mapValue(paginationDepth, endpoint)
+}
+
+// countRetry records that the operation is retried, e.g. an asset upload after the existing asset has been deleted.
+func countRetry(operation string) {
+	retries.Add(operation, 1)
+}
+
+// wait sleeps for d and records the wait by reason, e.g. while polling for an approval.
+func wait(reason string, d time.Duration) {
+	waits.Add(reason, 1)
+	waitTime.Add(reason, d.Milliseconds())
+	time.Sleep(d)
+}
+
+func mapValue(m *expvar.Map, key string) int64 {
+	if current, ok := m.Get(key).(*expvar.Int); ok {
+		return current.Value()
+	}
+	return 0
+}
+
+func mapValues(m *expvar.Map) map[string]int64 {
+	values := make(map[string]int64)
+	m.Do(func(kv expvar.KeyValue) {
+		if v, ok := kv.Value.(*expvar.Int); ok {
+			values[kv.Key] = v.Value()
+		}
+	})
+	return values
+}
+
+func writeLabeledMetric(buf *bytes.Buffer, name, label string, values map[string]int64) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(buf, "%s{%s=%q} %d\n", name, label, k, values[k])
+	}
+}
+
+// prometheusMetrics renders the metrics in the Prometheus text exposition format.
+func prometheusMetrics() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("# TYPE github_api_calls_total counter\n")
+	writeLabeledMetric(&buf, "github_api_calls_total", "endpoint", mapValues(apiCalls))
+	buf.WriteString("# TYPE github_api_errors_total counter\n")
+	writeLabeledMetric(&buf, "github_api_errors_total", "status", mapValues(apiErrors))
+	fmt.Fprintf(&buf, "# TYPE github_rate_limited_total counter\ngithub_rate_limited_total %d\n", rateLimited.Value())
+	buf.WriteString("# TYPE github_retries_total counter\n")
+	writeLabeledMetric(&buf, "github_retries_total", "operation", mapValues(retries))
+	buf.WriteString("# TYPE github_waits_total counter\n")
+	writeLabeledMetric(&buf, "github_waits_total", "reason", mapValues(waits))
+	buf.WriteString("# TYPE github_wait_ms_total counter\n")
+	writeLabeledMetric(&buf, "github_wait_ms_total", "reason", mapValues(waitTime))
+	buf.WriteString("# TYPE github_pagination_depth gauge\n")
+	writeLabeledMetric(&buf, "github_pagination_depth", "endpoint", mapValues(paginationDepth))
+
+	buf.WriteString("# TYPE github_api_latency_ms histogram\n")
+	buckets := mapValues(apiLatency)
+	count := int64(0)
+	for _, bound := range latencyBuckets {
+		count += buckets[strconv.FormatInt(bound, 10)]
+		fmt.Fprintf(&buf, "github_api_latency_ms_bucket{le=\"%d\"} %d\n", bound, count)
+	}
+	count += buckets["+Inf"]
+	fmt.Fprintf(&buf, "github_api_latency_ms_bucket{le=\"+Inf\"} %d\n", count)
+	fmt.Fprintf(&buf, "github_api_latency_ms_sum %d\ngithub_api_latency_ms_count %d\n", apiLatencySum.Value(), count)
+	return buf.Bytes()
+}
+
+// pushMetrics pushes the metrics to a Prometheus Pushgateway, grouped by repository. Failures are only logged.
+func (repo *GitHubRepository) pushMetrics() {
+	url := fmt.Sprintf("%s/metrics/job/semantic-release/owner/%s/repo/%s", strings.TrimSuffix(repo.metricsPushgateway, "/"), repo.owner, repo.repo)
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(prometheusMetrics()))
+	if err != nil {
+		log.Printf("warning: failed to push metrics: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "text/plain; version=0.0.4")
+	resp, err := metricsClient.Do(req)
+	if err != nil {
+		log.Printf("warning: failed to push metrics: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Printf("warning: failed to push metrics: %s", resp.Status)
+	}
+}
+
+// serveMetrics serves the expvar metrics on /debug/vars and the Prometheus metrics on /metrics for the lifetime of
+// the plugin process.
+func (repo *GitHubRepository) serveMetrics(addr string) error {
+	if repo.metricsServer != nil {
+		_ = repo.metricsServer.Shutdown(context.Background())
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on github_metrics_addr: %w", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_, _ = w.Write(prometheusMetrics())
+	})
+	repo.metricsServer = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func(server *http.Server) {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			log.Printf("warning: metrics server failed: %v", err)
+		}
+	}(repo.metricsServer)
+	return nil
+}
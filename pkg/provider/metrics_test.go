@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	testCases := map[string]string{
+		"/repos/owner/repo/releases/123/assets":                   "/repos/{owner}/{repo}/releases/{id}/assets",
+		"/api/v3/repos/owner/repo/git/ref/tags/v1.0.0":            "/repos/{owner}/{repo}/git/ref/{ref}",
+		"/repos/owner/repo/compare/v1.0.0...main":                 "/repos/{owner}/{repo}/compare/{basehead}",
+		"/repos/owner/repo/contents/docs/CHANGELOG.md":            "/repos/{owner}/{repo}/contents/{path}",
+		"/repos/owner/repo/issues/12/comments":                    "/repos/{owner}/{repo}/issues/{id}/comments",
+		"/repos/owner/repo/commits/" + strings.Repeat(testSHA, 5): "/repos/{owner}/{repo}/commits/{sha}",
+	}
+	for path, endpoint := range testCases {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		require.Equal(t, "GET "+endpoint, metricsEndpoint(r))
+	}
+}
+
+func TestGithubMetricsPushgateway(t *testing.T) {
+	var pushedPath, pushed string
+	pushgateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		pushedPath, pushed = r.URL.Path, string(body)
+	}))
+	defer pushgateway.Close()
+	repo, ts, _ := getNewGithubRecordingTestRepo(t, map[string]string{"github_metrics_pushgateway": pushgateway.URL + "/"})
+	defer ts.Close()
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, "/metrics/job/semantic-release/owner/owner/repo/test-repo", pushedPath)
+	require.Contains(t, pushed, "github_api_calls_total{endpoint=\"POST /repos/{owner}/{repo}/releases\"}")
+	require.Contains(t, pushed, "github_api_latency_ms_bucket{le=\"+Inf\"}")
+	require.Contains(t, pushed, "# TYPE github_retries_total counter\n")
+	require.Contains(t, pushed, "# TYPE github_waits_total counter\n")
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-github/v66/github"
+)
+
+const (
+	defaultMilestoneTitle = "{{.Version}}"
+	nextMilestonePatch    = "patch"
+	nextMilestoneMinor    = "minor"
+	nextMilestoneMajor    = "major"
+)
+
+func renderMilestoneTitle(tmpl *template.Template, data releaseNameData) (string, error) {
+	var title strings.Builder
+	if err := tmpl.Execute(&title, data); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", tmpl.Name(), err)
+	}
+	return strings.TrimSpace(title.String()), nil
+}
+
+func parseMilestoneTitle(option, value string) (*template.Template, error) {
+	tmpl, err := template.New(option).Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", option, err)
+	}
+	return tmpl, nil
+}
+
+// nextMilestoneVersion returns the version that follows version if it is bumped as configured by
+// github_next_milestone.
+func nextMilestoneVersion(version, bump string) string {
+	v := semver.MustParse(version)
+	switch bump {
+	case nextMilestoneMajor:
+		return v.IncMajor().String()
+	case nextMilestoneMinor:
+		return v.IncMinor().String()
+	default:
+		return v.IncPatch().String()
+	}
+}
+
+// findMilestone returns the open or closed milestone with the given title or nil if there is none.
+func (repo *GitHubRepository) findMilestone(title string) (*github.Milestone, error) {
+	opts := &github.MilestoneListOptions{State: "all", ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		milestones, resp, err := repo.client.Issues.ListMilestones(context.Background(), repo.owner, repo.repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list milestones: %w", err)
+		}
+		for _, milestone := range milestones {
+			if milestone.GetTitle() == title {
+				return milestone, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// findOrCreateMilestone returns the milestone with the given title, it is created if it does not exist yet.
+func (repo *GitHubRepository) findOrCreateMilestone(title string) (*github.Milestone, error) {
+	milestone, err := repo.findMilestone(title)
+	if err != nil || milestone != nil {
+		return milestone, err
+	}
+	milestone, _, err = repo.client.Issues.CreateMilestone(context.Background(), repo.owner, repo.repo, &github.Milestone{
+		Title: github.String(title),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create milestone %s: %w", title, err)
+	}
+	return milestone, nil
+}
+
+// closeReleaseMilestone assigns the pull requests and issues included in the release to the milestone of the
+// version and closes it. Prereleases are part of the milestone of their stable version, so they are skipped.
+func (repo *GitHubRepository) closeReleaseMilestone(data releaseNameData, sha string) error {
+	if data.Channel != "" {
+		return nil
+	}
+	title, err := renderMilestoneTitle(repo.milestoneTitleTemplate, data)
+	if err != nil {
+		return err
+	}
+	milestone, err := repo.findOrCreateMilestone(title)
+	if err != nil {
+		return err
+	}
+	prNumbers, issueNumbers, err := repo.releasedItems(data.Version, sha)
+	if err != nil {
+		return err
+	}
+	for _, number := range append(prNumbers, issueNumbers...) {
+		_, _, err := repo.client.Issues.Edit(context.Background(), repo.owner, repo.repo, number, &github.IssueRequest{
+			Milestone: milestone.Number,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to assign #%d to milestone %s: %w", number, title, err)
+		}
+	}
+	if milestone.GetState() != "closed" {
+		_, _, err = repo.client.Issues.EditMilestone(context.Background(), repo.owner, repo.repo, milestone.GetNumber(), &github.Milestone{
+			State: github.String("closed"),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to close milestone %s: %w", title, err)
+		}
+	}
+	if repo.nextMilestone == "" {
+		return nil
+	}
+	// planning continues with the milestone of the anticipated next version
+	nextVersion := nextMilestoneVersion(data.Version, repo.nextMilestone)
+	nextTitle, err := renderMilestoneTitle(repo.nextMilestoneTitle, newReleaseNameData(nextVersion, repo.formatTag(nextVersion), data.Branch))
+	if err != nil {
+		return err
+	}
+	_, err = repo.findOrCreateMilestone(nextTitle)
+	return err
+}
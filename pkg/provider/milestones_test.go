@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func handleReleasedItems(rec *githubRecorder) {
+	rec.handle("GET /repos/owner/test-repo/compare/v1.1.1...deadbeef", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.CommitsComparison{Commits: []*github.RepositoryCommit{
+			createGithubCommit("abcd", "feat: new feature\n\nCloses #10"),
+		}})
+	})
+	rec.handle("GET /repos/owner/test-repo/commits/abcd/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]*github.PullRequest{createGithubPullRequest(3, "feat", "alice")})
+	})
+}
+
+func TestGithubMilestones(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_milestones":      "true",
+		"github_milestone_title": "v{{.Version}}",
+	})
+	defer ts.Close()
+	handleReleasedItems(rec)
+	rec.handle("GET /repos/owner/test-repo/milestones", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "all", r.URL.Query().Get("state"))
+		_ = json.NewEncoder(w).Encode([]*github.Milestone{{Number: github.Int(1), Title: github.String("v1.1.1")}})
+	})
+	var created *github.Milestone
+	rec.handle("POST /repos/owner/test-repo/milestones", func(w http.ResponseWriter, r *http.Request) {
+		created = &github.Milestone{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(created))
+		created.Number = github.Int(2)
+		created.State = github.String("open")
+		_ = json.NewEncoder(w).Encode(created)
+	})
+	assigned := make(map[int]int)
+	for _, number := range []int{3, 10} {
+		number := number
+		rec.handle(fmt.Sprintf("PATCH /repos/owner/test-repo/issues/%d", number), func(w http.ResponseWriter, r *http.Request) {
+			var issue github.IssueRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&issue))
+			assigned[number] = issue.GetMilestone()
+			fmt.Fprint(w, "{}")
+		})
+	}
+	var closed *github.Milestone
+	rec.handle("PATCH /repos/owner/test-repo/milestones/2", func(w http.ResponseWriter, r *http.Request) {
+		closed = &github.Milestone{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(closed))
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, "v2.0.0", created.GetTitle())
+	require.Equal(t, map[int]int{3: 2, 10: 2}, assigned)
+	require.Equal(t, "closed", closed.GetState())
+}
+
+func TestGithubMilestonesSkipPrereleases(t *testing.T) {
+	repo := &GitHubRepository{}
+	require.NoError(t, repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_milestones": "true"}))
+	// no API requests are sent for prereleases
+	require.NoError(t, repo.closeReleaseMilestone(newReleaseNameData("2.0.0-rc.1", "v2.0.0-rc.1", "master"), testSHA))
+
+	_, err := parseMilestoneTitle("github_milestone_title", "{{.Version")
+	require.ErrorContains(t, err, "failed to parse github_milestone_title")
+}
+
+func TestGithubNextMilestone(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_milestones":           "true",
+		"github_next_milestone":       "minor",
+		"github_next_milestone_title": "{{.Tag}} (planned)",
+	})
+	defer ts.Close()
+	handleReleasedItems(rec)
+	rec.handle("GET /repos/owner/test-repo/milestones", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]*github.Milestone{{Number: github.Int(1), Title: github.String("2.0.0"), State: github.String("open")}})
+	})
+	created := make([]string, 0)
+	rec.handle("POST /repos/owner/test-repo/milestones", func(w http.ResponseWriter, r *http.Request) {
+		milestone := &github.Milestone{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(milestone))
+		created = append(created, milestone.GetTitle())
+		_ = json.NewEncoder(w).Encode(milestone)
+	})
+	rec.handle("PATCH /repos/owner/test-repo/issues/3", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "{}")
+	})
+	rec.handle("PATCH /repos/owner/test-repo/issues/10", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "{}")
+	})
+	closed := false
+	rec.handle("PATCH /repos/owner/test-repo/milestones/1", func(w http.ResponseWriter, _ *http.Request) {
+		closed = true
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.True(t, closed)
+	require.Equal(t, []string{"v2.1.0 (planned)"}, created)
+}
+
+func TestGithubNextMilestoneConfig(t *testing.T) {
+	require.Equal(t, "1.2.4", nextMilestoneVersion("1.2.3", nextMilestonePatch))
+	require.Equal(t, "1.3.0", nextMilestoneVersion("1.2.3", nextMilestoneMinor))
+	require.Equal(t, "2.0.0", nextMilestoneVersion("1.2.3", nextMilestoneMajor))
+
+	repo := &GitHubRepository{}
+	err := repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_next_milestone": "minor"})
+	require.EqualError(t, err, "github_next_milestone requires github_milestones")
+	err = repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_milestones": "true", "github_next_milestone": "next"})
+	require.EqualError(t, err, "invalid value for github_next_milestone: next")
+}
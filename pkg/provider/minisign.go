@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/scrypt"
+)
+
+// minisignKey is a decoded minisign secret key, see https://jedisct1.github.io/minisign/
+type minisignKey struct {
+	keyID      [8]byte
+	privateKey ed25519.PrivateKey
+}
+
+const minisignSecretKeyLength = 158
+
+func minisignScryptParams(opsLimit, memLimit uint64) (n, r, p int) {
+	if opsLimit < 32768 {
+		opsLimit = 32768
+	}
+	r = 8
+	var maxN uint64
+	if opsLimit < memLimit/32 {
+		p = 1
+		maxN = opsLimit / (uint64(r) * 4)
+	} else {
+		maxN = memLimit / (uint64(r) * 128)
+	}
+	nLog2 := uint(1)
+	for ; nLog2 < 63; nLog2++ {
+		if uint64(1)<<nLog2 > maxN/2 {
+			break
+		}
+	}
+	if p == 0 {
+		maxRP := (opsLimit / 4) / (uint64(1) << nLog2)
+		if maxRP > 0x3fffffff {
+			maxRP = 0x3fffffff
+		}
+		p = int(maxRP) / r
+	}
+	return 1 << nLog2, r, p
+}
+
+// minisignKeyStream derives the stream the encrypted key material is XORed with.
+func minisignKeyStream(password string, salt []byte, opsLimit, memLimit uint64) ([]byte, error) {
+	n, r, p := minisignScryptParams(opsLimit, memLimit)
+	return scrypt.Key([]byte(password), salt, n, r, p, 104)
+}
+
+func minisignChecksum(sigAlg, keyID, privateKey []byte) []byte {
+	sum := blake2b.Sum256(bytes.Join([][]byte{sigAlg, keyID, privateKey}, nil))
+	return sum[:]
+}
+
+func loadMinisignKey(rawKey, password string) (*minisignKey, error) {
+	lines := strings.Split(strings.TrimSpace(rawKey), "\n")
+	encodedKey := strings.TrimSpace(lines[len(lines)-1])
+	decoded, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode minisign private key: %w", err)
+	}
+	if len(decoded) != minisignSecretKeyLength || string(decoded[:2]) != "Ed" || string(decoded[4:6]) != "B2" {
+		return nil, errors.New("invalid minisign private key")
+	}
+	kdfAlg := decoded[2:4]
+	salt := decoded[6:38]
+	opsLimit := binary.LittleEndian.Uint64(decoded[38:46])
+	memLimit := binary.LittleEndian.Uint64(decoded[46:54])
+	keyData := decoded[54:]
+	switch {
+	case string(kdfAlg) == "Sc":
+		if password == "" {
+			return nil, errors.New("minisign private key is encrypted but no password was provided")
+		}
+		stream, err := minisignKeyStream(password, salt, opsLimit, memLimit)
+		if err != nil {
+			return nil, err
+		}
+		for i := range keyData {
+			keyData[i] ^= stream[i]
+		}
+	case kdfAlg[0] != 0 || kdfAlg[1] != 0:
+		return nil, fmt.Errorf("unsupported minisign kdf algorithm: %q", kdfAlg)
+	}
+	key := &minisignKey{privateKey: ed25519.PrivateKey(keyData[8:72])}
+	copy(key.keyID[:], keyData[:8])
+	if !bytes.Equal(minisignChecksum(decoded[:2], keyData[:8], keyData[8:72]), keyData[72:]) {
+		return nil, errors.New("invalid minisign private key checksum (wrong password?)")
+	}
+	return key, nil
+}
+
+// minisignSign creates a prehashed minisign signature file for the given data.
+func minisignSign(key *minisignKey, fileName string, data []byte) []byte {
+	hash := blake2b.Sum512(data)
+	signature := ed25519.Sign(key.privateKey, hash[:])
+	trustedComment := fmt.Sprintf("timestamp:%d\tfile:%s\thashed", time.Now().Unix(), fileName)
+	globalSignature := ed25519.Sign(key.privateKey, append(append([]byte{}, signature...), trustedComment...))
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, "untrusted comment: signature from minisign secret key")
+	fmt.Fprintln(buf, base64.StdEncoding.EncodeToString(bytes.Join([][]byte{[]byte("ED"), key.keyID[:], signature}, nil)))
+	fmt.Fprintln(buf, "trusted comment: "+trustedComment)
+	fmt.Fprintln(buf, base64.StdEncoding.EncodeToString(globalSignature))
+	return buf.Bytes()
+}
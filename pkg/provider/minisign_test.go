@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+)
+
+func createTestMinisignKey(t *testing.T, password string) (string, ed25519.PublicKey) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keyID := []byte("semrelid")
+	salt := make([]byte, 32)
+	_, err = rand.Read(salt)
+	require.NoError(t, err)
+
+	kdfAlg := []byte{0, 0}
+	keyData := bytes.Join([][]byte{keyID, privateKey, minisignChecksum([]byte("Ed"), keyID, privateKey)}, nil)
+	limits := make([]byte, 16)
+	if password != "" {
+		kdfAlg = []byte("Sc")
+		binary.LittleEndian.PutUint64(limits[:8], 32768)
+		binary.LittleEndian.PutUint64(limits[8:], 16*1024*1024)
+		stream, err := minisignKeyStream(password, salt, 32768, 16*1024*1024)
+		require.NoError(t, err)
+		for i := range keyData {
+			keyData[i] ^= stream[i]
+		}
+	}
+	raw := bytes.Join([][]byte{[]byte("Ed"), kdfAlg, []byte("B2"), salt, limits, keyData}, nil)
+	return "untrusted comment: minisign encrypted secret key\n" + base64.StdEncoding.EncodeToString(raw) + "\n", publicKey
+}
+
+func TestMinisignSign(t *testing.T) {
+	key, publicKey := createTestMinisignKey(t, "secret")
+
+	_, err := loadMinisignKey(key, "")
+	require.ErrorContains(t, err, "no password")
+	_, err = loadMinisignKey(key, "wrong")
+	require.ErrorContains(t, err, "checksum")
+
+	mKey, err := loadMinisignKey(key, "secret")
+	require.NoError(t, err)
+
+	data := []byte("deadbeef  provider-github_linux_amd64\n")
+	lines := strings.Split(strings.TrimSpace(string(minisignSign(mKey, "checksums.txt", data))), "\n")
+	require.Len(t, lines, 4)
+
+	sig, err := base64.StdEncoding.DecodeString(lines[1])
+	require.NoError(t, err)
+	require.Equal(t, "ED", string(sig[:2]))
+	require.Equal(t, "semrelid", string(sig[2:10]))
+	hash := blake2b.Sum512(data)
+	require.True(t, ed25519.Verify(publicKey, hash[:], sig[10:]))
+
+	trustedComment := strings.TrimPrefix(lines[2], "trusted comment: ")
+	require.Contains(t, trustedComment, "file:checksums.txt")
+	globalSig, err := base64.StdEncoding.DecodeString(lines[3])
+	require.NoError(t, err)
+	require.True(t, ed25519.Verify(publicKey, append(sig[10:], trustedComment...), globalSig))
+}
+
+func TestMinisignUnencryptedKey(t *testing.T) {
+	key, _ := createTestMinisignKey(t, "")
+	_, err := loadMinisignKey(key, "")
+	require.NoError(t, err)
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+type mirrorRepo struct {
+	owner string
+	repo  string
+}
+
+func parseMirrorRepos(value string) ([]mirrorRepo, error) {
+	mirrors := make([]mirrorRepo, 0)
+	for _, slug := range splitList(value) {
+		owner, name, ok := strings.Cut(slug, "/")
+		if !ok || owner == "" || name == "" {
+			return nil, fmt.Errorf("invalid github_mirror_repos entry: %s", slug)
+		}
+		mirrors = append(mirrors, mirrorRepo{owner: owner, repo: name})
+	}
+	return mirrors, nil
+}
+
+func (repo *GitHubRepository) listAllReleaseAssets(owner, name string, releaseID int64) ([]*github.ReleaseAsset, error) {
+	allAssets := make([]*github.ReleaseAsset, 0)
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		assets, resp, err := repo.client.Repositories.ListReleaseAssets(context.Background(), owner, name, releaseID, opts)
+		if err != nil {
+			return nil, err
+		}
+		allAssets = append(allAssets, assets...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return allAssets, nil
+}
+
+// copyReleaseAsset streams the asset of the release into the upload to the release of the mirror, assets can be up to
+// 2 GiB and are not buffered in memory.
+func (repo *GitHubRepository) copyReleaseAsset(mirror mirrorRepo, releaseID int64, asset *github.ReleaseAsset) error {
+	rc, _, err := repo.client.Repositories.DownloadReleaseAsset(context.Background(), repo.releaseOwner, repo.releaseRepo, asset.GetID(), http.DefaultClient)
+	if err != nil {
+		return fmt.Errorf("failed to download asset %s: %w", asset.GetName(), err)
+	}
+	defer rc.Close()
+	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets?name=%s", mirror.owner, mirror.repo, releaseID, url.QueryEscape(asset.GetName()))
+	req, err := repo.client.NewUploadRequest(u, rc, int64(asset.GetSize()), assetMediaType(asset.GetName()))
+	if err != nil {
+		return err
+	}
+	if _, err := repo.client.Do(context.Background(), req, nil); err != nil {
+		return fmt.Errorf("failed to upload asset %s: %w", asset.GetName(), err)
+	}
+	return nil
+}
+
+// mirrorReleaseTo creates or updates a copy of the release in the mirror, including its assets. Assets that already
+// exist in the mirror (e.g. from a previous run) are kept.
+func (repo *GitHubRepository) mirrorReleaseTo(mirror mirrorRepo, tag, sha string, release *github.RepositoryRelease) error {
+	if repo.mirrorTags {
+		// the commit has to exist in the mirror, otherwise GitHub creates the tag from the default branch
+		_, _, err := repo.client.Git.CreateRef(context.Background(), mirror.owner, mirror.repo, &github.Reference{
+			Ref:    github.String("refs/tags/" + tag),
+			Object: &github.GitObject{SHA: github.String(sha)},
+		})
+		if err != nil && !isAlreadyExistsError(err) {
+			return fmt.Errorf("failed to create tag: %w", err)
+		}
+	}
+	opts := &github.RepositoryRelease{
+		TagName:    github.String(tag),
+		Name:       github.String(release.GetName()),
+		Body:       github.String(release.GetBody()),
+		Prerelease: github.Bool(release.GetPrerelease()),
+	}
+	mirrored, resp, err := repo.client.Repositories.GetReleaseByTag(context.Background(), mirror.owner, mirror.repo, tag)
+	switch {
+	case err == nil:
+		mirrored, _, err = repo.client.Repositories.EditRelease(context.Background(), mirror.owner, mirror.repo, mirrored.GetID(), opts)
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		mirrored, _, err = repo.client.Repositories.CreateRelease(context.Background(), mirror.owner, mirror.repo, opts)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create release: %w", err)
+	}
+
+	assets, err := repo.listAllReleaseAssets(repo.releaseOwner, repo.releaseRepo, release.GetID())
+	if err != nil {
+		return fmt.Errorf("failed to list release assets: %w", err)
+	}
+	mirroredAssets, err := repo.listAllReleaseAssets(mirror.owner, mirror.repo, mirrored.GetID())
+	if err != nil {
+		return fmt.Errorf("failed to list release assets: %w", err)
+	}
+	existing := make(map[string]bool, len(mirroredAssets))
+	for _, asset := range mirroredAssets {
+		existing[asset.GetName()] = true
+	}
+	for _, asset := range assets {
+		if existing[asset.GetName()] {
+			continue
+		}
+		if err := repo.copyReleaseAsset(mirror, mirrored.GetID(), asset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mirrorRelease copies the release to all configured mirrors.
+func (repo *GitHubRepository) mirrorRelease(tag, sha string, release *github.RepositoryRelease) error {
+	for _, mirror := range repo.mirrorRepos {
+		if err := repo.mirrorReleaseTo(mirror, tag, sha, release); err != nil {
+			return fmt.Errorf("failed to mirror release %s to %s/%s: %w", tag, mirror.owner, mirror.repo, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMirrorRepos(t *testing.T) {
+	mirrors, err := parseMirrorRepos("owner/a, other/b")
+	require.NoError(t, err)
+	require.Equal(t, []mirrorRepo{{"owner", "a"}, {"other", "b"}}, mirrors)
+	_, err = parseMirrorRepos("owner")
+	require.EqualError(t, err, "invalid github_mirror_repos entry: owner")
+}
+
+func TestGithubMirrorRelease(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_mirror_repos": "owner/mirror",
+		"github_mirror_tags":  "true",
+	})
+	defer ts.Close()
+	rec.handle("POST /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.RepositoryRelease{ID: github.Int64(1), Name: github.String("v2.0.0"), Body: github.String("notes")})
+	})
+	rec.handle("GET /repos/owner/test-repo/releases/1/assets", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[{"id":7,"name":"app.tar.gz","size":6},{"id":8,"name":"checksums.txt","size":9}]`)
+	})
+	rec.handle("GET /repos/owner/test-repo/releases/assets/7", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/octet-stream", r.Header.Get("Accept"))
+		fmt.Fprint(w, "binary")
+	})
+	var mirrorRef map[string]interface{}
+	rec.handle("POST /repos/owner/mirror/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&mirrorRef))
+		fmt.Fprint(w, "{}")
+	})
+	rec.handle("GET /repos/owner/mirror/releases/tags/v2.0.0", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+	var mirrored github.RepositoryRelease
+	rec.handle("POST /repos/owner/mirror/releases", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&mirrored))
+		fmt.Fprint(w, `{"id":2}`)
+	})
+	// the checksums were already mirrored by a previous run
+	rec.handle("GET /repos/owner/mirror/releases/2/assets", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[{"id":9,"name":"checksums.txt"}]`)
+	})
+	uploaded := make(map[string]string)
+	rec.handle("POST /repos/owner/mirror/releases/2/assets", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, int64(6), r.ContentLength)
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		uploaded[r.URL.Query().Get("name")] = string(data)
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"ref": "refs/tags/v2.0.0", "sha": testSHA}, mirrorRef)
+	require.Equal(t, "v2.0.0", mirrored.GetTagName())
+	require.Equal(t, "notes", mirrored.GetBody())
+	require.Equal(t, map[string]string{"app.tar.gz": "binary"}, uploaded)
+}
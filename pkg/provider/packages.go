@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// packageConfig describes a package of a monorepo that is released independently.
+type packageConfig struct {
+	Paths       []string `yaml:"paths"`
+	PathsIgnore []string `yaml:"paths_ignore"`
+	TagPrefix   *string  `yaml:"tag_prefix"`
+}
+
+type packagesConfig struct {
+	Packages map[string]*packageConfig `yaml:"packages"`
+}
+
+// applyPackage scopes the repository to the selected package of the packages configuration (inline YAML or a file),
+// commits are filtered by the paths of the package and tags are created and parsed using its tag prefix.
+func (repo *GitHubRepository) applyPackage(rawConfig, name string) error {
+	if name == "" {
+		return nil
+	}
+	if rawConfig == "" {
+		return errors.New("package requires the packages option")
+	}
+	data, err := readInlineOrFile(rawConfig)
+	if err != nil {
+		return fmt.Errorf("failed to read packages: %w", err)
+	}
+	config := &packagesConfig{}
+	if err := yaml.Unmarshal([]byte(data), config); err != nil {
+		return fmt.Errorf("failed to parse packages: %w", err)
+	}
+	pkg, ok := config.Packages[name]
+	if !ok || pkg == nil {
+		names := make([]string, 0, len(config.Packages))
+		for n := range config.Packages {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown package %s, available packages: %s", name, strings.Join(names, ", "))
+	}
+	if len(pkg.Paths) == 0 {
+		return fmt.Errorf("package %s has no paths", name)
+	}
+	repo.pathFilter = newPathFilter(pkg.Paths, pkg.PathsIgnore)
+	repo.tagPrefix = name + "/"
+	if pkg.TagPrefix != nil {
+		repo.tagPrefix = *pkg.TagPrefix
+	}
+	return nil
+}
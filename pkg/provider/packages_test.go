@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+const testPackages = `
+packages:
+  api:
+    paths: [api]
+  web:
+    paths: [web/**]
+    paths_ignore: ["**/*.md"]
+    tag_prefix: frontend-
+`
+
+func TestGithubPackage(t *testing.T) {
+	packagesFile := filepath.Join(t.TempDir(), "packages.yml")
+	require.NoError(t, os.WriteFile(packagesFile, []byte(testPackages), 0o600))
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"packages": packagesFile,
+		"package":  "api",
+	})
+	defer ts.Close()
+	handleCommitFiles(rec, testCommitFiles)
+	rec.handle("GET /repos/owner/test-repo/git/matching-refs/tags/api/", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]*github.Reference{createGithubRef("refs/tags/api/v1.0.0")})
+	})
+	var createdRef map[string]string
+	rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&createdRef))
+		fmt.Fprint(w, "{}")
+	})
+
+	commits, err := repo.GetCommits("2222", "1111")
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+
+	releases, err := repo.GetReleases("")
+	require.NoError(t, err)
+	require.Len(t, releases, 1)
+	require.Equal(t, "1.0.0", releases[0].Version)
+
+	validTags["api/v1.1.0"] = true
+	defer delete(validTags, "api/v1.1.0")
+	err = repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "1.1.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, "refs/tags/api/v1.1.0", createdRef["ref"])
+}
+
+func TestGithubPackageConfig(t *testing.T) {
+	repo := &GitHubRepository{}
+	require.NoError(t, repo.applyPackage(testPackages, "web"))
+	require.Equal(t, "frontend-", repo.tagPrefix)
+	require.True(t, repo.pathFilter.matches([]string{"web/index.html"}))
+	require.False(t, repo.pathFilter.matches([]string{"web/README.md"}))
+
+	require.ErrorContains(t, repo.applyPackage(testPackages, "cli"), "unknown package cli, available packages: api, web")
+	require.ErrorContains(t, repo.applyPackage("", "api"), "requires the packages option")
+	require.ErrorContains(t, repo.applyPackage("packages:\n  api: {}", "api"), "package api has no paths")
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+)
+
+// pathFilter selects the commits that touch the configured paths, e.g. to release a single directory of a monorepo.
+type pathFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// compileGlob converts a glob to a regular expression. ** matches across directories, * and ? only within a single
+// path segment. A pattern also matches everything below the directory it describes.
+func compileGlob(pattern string) *regexp.Regexp {
+	pattern = strings.TrimPrefix(strings.TrimSuffix(pattern, "/"), "./")
+	sb := &strings.Builder{}
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			sb.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			sb.WriteString("[^/]*")
+		case pattern[i] == '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	sb.WriteString("(/.*)?$")
+	return regexp.MustCompile(sb.String())
+}
+
+func compileGlobs(patterns []string) []*regexp.Regexp {
+	globs := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		globs = append(globs, compileGlob(pattern))
+	}
+	return globs
+}
+
+func newPathFilter(paths, pathsIgnore []string) *pathFilter {
+	if len(paths) == 0 && len(pathsIgnore) == 0 {
+		return nil
+	}
+	return &pathFilter{include: compileGlobs(paths), exclude: compileGlobs(pathsIgnore)}
+}
+
+func matchesAny(globs []*regexp.Regexp, file string) bool {
+	for _, glob := range globs {
+		if glob.MatchString(file) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether one of the files is not ignored and, if paths are configured, matches one of them.
+func (f *pathFilter) matches(files []string) bool {
+	for _, file := range files {
+		if matchesAny(f.exclude, file) {
+			continue
+		}
+		if len(f.include) == 0 || matchesAny(f.include, file) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileGlob(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		file    string
+		matches bool
+	}{
+		{"api", "api/main.go", true},
+		{"api/", "api/v1/main.go", true},
+		{"api", "apis/main.go", false},
+		{"api/*.go", "api/main.go", true},
+		{"api/*.go", "api/v1/main.go", false},
+		{"api/**/*.go", "api/main.go", true},
+		{"api/**/*.go", "api/v1/main.go", true},
+		{"**/*.md", "README.md", true},
+		{"**/*.md", "docs/index.md", true},
+		{"**/*.md", "docs/index.mdx", false},
+		{"go.?od", "go.mod", true},
+		{"./web", "web/index.html", true},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.matches, compileGlob(tc.pattern).MatchString(tc.file), "%s %s", tc.pattern, tc.file)
+	}
+}
+
+func TestPathFilter(t *testing.T) {
+	require.Nil(t, newPathFilter(nil, nil))
+	filter := newPathFilter([]string{"api"}, []string{"**/*.md"})
+	require.True(t, filter.matches([]string{"api/main.go", "web/index.html"}))
+	require.False(t, filter.matches([]string{"api/README.md"}))
+	require.False(t, filter.matches([]string{"web/index.html"}))
+	require.False(t, filter.matches(nil))
+
+	ignoreOnly := newPathFilter(nil, []string{"docs"})
+	require.True(t, ignoreOnly.matches([]string{"docs/index.md", "main.go"}))
+	require.False(t, ignoreOnly.matches([]string{"docs/index.md"}))
+}
+
+func TestGithubGetCommitsPathFilter(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"paths":        "api/**",
+		"paths_ignore": "**/*.md",
+	})
+	defer ts.Close()
+	handleCommitFiles(rec, testCommitFiles)
+
+	commits, err := repo.GetCommits("2222", "1111")
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.Equal(t, "1111", commits[0].SHA)
+	require.Equal(t, "dcba", commits[1].SHA)
+	require.NotContains(t, commits[0].Annotations, "files")
+}
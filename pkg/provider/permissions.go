@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// viewerPermissions maps the GraphQL viewerPermission to the permissions of the REST API.
+var viewerPermissions = map[string][]string{
+	"ADMIN":    {"admin", "maintain", "push", "triage", "pull"},
+	"MAINTAIN": {"maintain", "push", "triage", "pull"},
+	"WRITE":    {"push", "triage", "pull"},
+	"TRIAGE":   {"triage", "pull"},
+	"READ":     {"pull"},
+}
+
+type requiredPermission struct {
+	operation  string
+	permission string
+}
+
+// requiredPermissions returns the operations of the configured release and the repository permission they need.
+func (repo *GitHubRepository) requiredPermissions() []requiredPermission {
+	required := []requiredPermission{
+		{"create tags", "push"},
+		{"create releases", "push"},
+		{"upload release assets", "push"},
+	}
+	if repo.releaseLock {
+		required = append(required, requiredPermission{"create the release lock", "push"})
+	}
+	if repo.commitStatusContext != "" || repo.deploymentEnvironment != "" {
+		required = append(required, requiredPermission{"create commit statuses and deployments", "push"})
+	}
+	if len(repo.releasedLabels) > 0 || repo.milestones || repo.trackingIssue || repo.failureIssue {
+		required = append(required, requiredPermission{"update issues, labels and milestones", "triage"})
+	}
+	return required
+}
+
+// checkPermissions logs a report of the operations the token is (not) allowed to perform and fails if any are
+// missing. oauthScopes is the X-OAuth-Scopes header, which is only set for classic personal access tokens.
+func (repo *GitHubRepository) checkPermissions(permissions map[string]bool, oauthScopes string, private bool) error {
+	if permissions == nil {
+		log.Printf("warning: the permissions of the token for %s/%s could not be determined", repo.owner, repo.repo)
+		return nil
+	}
+	missing := make([]string, 0)
+	log.Printf("permissions of the token for %s/%s:", repo.owner, repo.repo)
+	for _, required := range repo.requiredPermissions() {
+		if permissions[required.permission] {
+			log.Printf("  ok       %s", required.operation)
+			continue
+		}
+		log.Printf("  missing  %s (requires the %s permission)", required.operation, required.permission)
+		missing = append(missing, required.operation)
+	}
+	if oauthScopes != "" {
+		scopes := splitList(oauthScopes)
+		hasScope := false
+		for _, scope := range scopes {
+			hasScope = hasScope || scope == "repo" || (scope == "public_repo" && !private)
+		}
+		if !hasScope {
+			log.Printf("  missing  the repo scope of the personal access token (has %s)", strings.Join(scopes, ", "))
+			missing = append(missing, "repo scope")
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the token lacks permissions on %s/%s: %s", repo.owner, repo.repo, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubPermissionCheck(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_permission_check": "true",
+		"github_milestones":       "true",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo", func(w http.ResponseWriter, _ *http.Request) {
+		readOnlyRepo := githubRepo
+		readOnlyRepo.Permissions = map[string]bool{"pull": true, "triage": true}
+		w.Header().Set("X-OAuth-Scopes", "read:org, public_repo")
+		_ = json.NewEncoder(w).Encode(readOnlyRepo)
+	})
+	_, err := repo.GetInfo()
+	// the repository is private, so public_repo is not sufficient
+	require.EqualError(t, err, "the token lacks permissions on owner/test-repo: create tags, create releases, upload release assets, repo scope")
+}
+
+func TestGithubPermissionCheckGraphQL(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_permission_check": "true",
+		"github_use_graphql":      "true",
+		"github_milestones":       "true",
+	})
+	defer ts.Close()
+	rec.handle("POST /graphql", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data":{"repository":{"name":"test-repo","owner":{"login":"owner"},"viewerPermission":"WRITE"}}}`)
+	})
+	_, err := repo.GetInfo()
+	require.NoError(t, err)
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-github/v66/github"
+)
+
+const (
+	cleanupPrereleasesDelete = "delete"
+	cleanupPrereleasesDraft  = "draft"
+)
+
+// cleanupSupersededPrereleases deletes or unpublishes the prereleases (e.g. v1.2.0-rc.1) that are superseded by the stable version.
+func (repo *GitHubRepository) cleanupSupersededPrereleases(version string) error {
+	if repo.cleanupPrereleases == "" {
+		return nil
+	}
+	stable, err := semver.NewVersion(version)
+	if err != nil {
+		return err
+	}
+	if stable.Prerelease() != "" {
+		return nil
+	}
+	superseded := make([]*github.RepositoryRelease, 0)
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		releases, resp, err := repo.client.Repositories.ListReleases(context.Background(), repo.releaseOwner, repo.releaseRepo, opts)
+		if err != nil {
+			return fmt.Errorf("failed to list releases: %w", err)
+		}
+		for _, r := range releases {
+			if r.GetDraft() {
+				continue
+			}
+			v, err := repo.parseTagVersion(r.GetTagName())
+			if err != nil || v.Prerelease() == "" {
+				continue
+			}
+			if v.Major() == stable.Major() && v.Minor() == stable.Minor() && v.Patch() == stable.Patch() {
+				superseded = append(superseded, r)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	for _, r := range superseded {
+		if repo.cleanupPrereleases == cleanupPrereleasesDraft {
+			_, _, err = repo.client.Repositories.EditRelease(context.Background(), repo.releaseOwner, repo.releaseRepo, r.GetID(), &github.RepositoryRelease{
+				Draft: github.Bool(true),
+			})
+		} else {
+			_, err = repo.client.Repositories.DeleteRelease(context.Background(), repo.releaseOwner, repo.releaseRepo, r.GetID())
+		}
+		if err != nil {
+			return fmt.Errorf("failed to clean up prerelease %s: %w", r.GetTagName(), err)
+		}
+	}
+	return nil
+}
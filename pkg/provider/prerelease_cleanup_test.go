@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+const testPrereleases = `[
+	{"id": 1, "tag_name": "v2.0.0-beta.1", "prerelease": true},
+	{"id": 2, "tag_name": "v2.0.0-rc.1", "prerelease": true},
+	{"id": 3, "tag_name": "v2.1.0-beta.1", "prerelease": true},
+	{"id": 4, "tag_name": "v1.9.0"},
+	{"id": 5, "tag_name": "v2.0.0-beta.2", "draft": true}
+]`
+
+func TestGithubCleanupPrereleases(t *testing.T) {
+	for _, mode := range []string{"delete", "draft"} {
+		t.Run(mode, func(t *testing.T) {
+			repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+				"github_cleanup_prereleases": mode,
+			})
+			defer ts.Close()
+			rec.handle("GET /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprint(w, testPrereleases)
+			})
+			cleanedUp := make([]string, 0)
+			for _, id := range []string{"1", "2", "3", "4", "5"} {
+				rec.handle("DELETE /repos/owner/test-repo/releases/"+id, func(w http.ResponseWriter, _ *http.Request) {
+					cleanedUp = append(cleanedUp, "delete "+id)
+					w.WriteHeader(http.StatusNoContent)
+				})
+				rec.handle("PATCH /repos/owner/test-repo/releases/"+id, func(w http.ResponseWriter, r *http.Request) {
+					data := &github.RepositoryRelease{}
+					require.NoError(t, json.NewDecoder(r.Body).Decode(data))
+					require.True(t, data.GetDraft())
+					cleanedUp = append(cleanedUp, "draft "+id)
+					fmt.Fprint(w, "{}")
+				})
+			}
+
+			require.NoError(t, repo.cleanupSupersededPrereleases("2.0.0-rc.2"))
+			require.Empty(t, cleanedUp)
+
+			err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+			require.NoError(t, err)
+			require.Equal(t, []string{mode + " 1", mode + " 2"}, cleanedUp)
+		})
+	}
+}
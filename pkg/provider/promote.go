@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-github/v66/github"
+)
+
+// findPromotablePrerelease returns the latest published prerelease of version (e.g. v1.2.0-rc.2 for 1.2.0) if its
+// tag points at sha, so that it can be promoted to the stable release instead of creating a new one.
+func (repo *GitHubRepository) findPromotablePrerelease(version, sha string) (*github.RepositoryRelease, error) {
+	stable, err := semver.NewVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	var candidate *github.RepositoryRelease
+	var candidateVersion *semver.Version
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		releases, resp, err := repo.client.Repositories.ListReleases(context.Background(), repo.releaseOwner, repo.releaseRepo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list releases: %w", err)
+		}
+		for _, r := range releases {
+			if r.GetDraft() {
+				continue
+			}
+			v, err := repo.parseTagVersion(r.GetTagName())
+			if err != nil || v.Prerelease() == "" {
+				continue
+			}
+			if v.Major() != stable.Major() || v.Minor() != stable.Minor() || v.Patch() != stable.Patch() {
+				continue
+			}
+			if candidateVersion == nil || v.GreaterThan(candidateVersion) {
+				candidate, candidateVersion = r, v
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	if candidate == nil {
+		return nil, nil
+	}
+	candidateSHA, err := repo.resolveTag(candidate.GetTagName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve tag %s: %w", candidate.GetTagName(), err)
+	}
+	if candidateSHA != sha {
+		return nil, nil
+	}
+	return candidate, nil
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubPromotePrerelease(t *testing.T) {
+	testCases := []struct {
+		name      string
+		sha       string
+		promotion bool
+	}{
+		{"same commit", testSHA, true},
+		{"other commit", "beefdead", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+				"github_promote_prereleases": "true",
+			})
+			defer ts.Close()
+			rec.handle("GET /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprint(w, `[
+					{"id": 1, "tag_name": "v2.0.0-rc.1", "prerelease": true, "body": "rc.1 notes"},
+					{"id": 2, "tag_name": "v2.0.0-rc.2", "prerelease": true, "body": "rc.2 notes"},
+					{"id": 3, "tag_name": "v1.1.1"}
+				]`)
+			})
+			rec.handle("GET /repos/owner/test-repo/git/ref/tags/v2.0.0-rc.2", func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprintf(w, `{"ref": "refs/tags/v2.0.0-rc.2", "object": {"type": "commit", "sha": %q}}`, tc.sha)
+			})
+			var promoted *github.RepositoryRelease
+			rec.handle("PATCH /repos/owner/test-repo/releases/2", func(w http.ResponseWriter, r *http.Request) {
+				promoted = &github.RepositoryRelease{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(promoted))
+				fmt.Fprint(w, `{"id": 2}`)
+			})
+
+			err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog"})
+			require.NoError(t, err)
+			if !tc.promotion {
+				require.Nil(t, promoted)
+				require.Equal(t, "v2.0.0", rec.lastRelease().GetTagName())
+				return
+			}
+			require.Nil(t, rec.lastRelease())
+			require.Equal(t, "v2.0.0", promoted.GetTagName())
+			require.False(t, promoted.GetPrerelease())
+			require.Equal(t, "changelog\n\nrc.2 notes", promoted.GetBody())
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+)
+
+const provenanceAssetName = "provenance.intoto.jsonl"
+
+type inTotoSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+type inTotoStatement struct {
+	Type          string           `json:"_type"`
+	Subject       []inTotoSubject  `json:"subject"`
+	PredicateType string           `json:"predicateType"`
+	Predicate     slsaProvenanceV1 `json:"predicate"`
+}
+
+type slsaResourceDescriptor struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest"`
+}
+
+type slsaProvenanceV1 struct {
+	BuildDefinition struct {
+		BuildType            string                   `json:"buildType"`
+		ExternalParameters   map[string]any           `json:"externalParameters"`
+		InternalParameters   map[string]any           `json:"internalParameters,omitempty"`
+		ResolvedDependencies []slsaResourceDescriptor `json:"resolvedDependencies"`
+	} `json:"buildDefinition"`
+	RunDetails struct {
+		Builder struct {
+			ID string `json:"id"`
+		} `json:"builder"`
+		Metadata struct {
+			InvocationID string `json:"invocationId,omitempty"`
+		} `json:"metadata"`
+	} `json:"runDetails"`
+}
+
+// createProvenanceStatement creates an (unsigned) in-toto statement with a SLSA v1 provenance predicate
+// that covers the given release assets. Workflow details are taken from the GitHub Actions environment if available.
+func createProvenanceStatement(release *provider.CreateReleaseConfig, tag, slug string, assets []*uploadedAsset) ([]byte, error) {
+	serverURL := os.Getenv("GITHUB_SERVER_URL")
+	if serverURL == "" {
+		serverURL = "https://github.com"
+	}
+	statement := inTotoStatement{
+		Type:          "https://in-toto.io/Statement/v1",
+		PredicateType: "https://slsa.dev/provenance/v1",
+	}
+	for _, asset := range assets {
+		statement.Subject = append(statement.Subject, inTotoSubject{Name: asset.name, Digest: map[string]string{"sha256": asset.sha256}})
+	}
+
+	predicate := &statement.Predicate
+	predicate.BuildDefinition.BuildType = "https://actions.github.io/buildtypes/workflow/v1"
+	predicate.BuildDefinition.ExternalParameters = map[string]any{
+		"workflow": map[string]string{
+			"ref":        os.Getenv("GITHUB_REF"),
+			"repository": fmt.Sprintf("%s/%s", serverURL, slug),
+			"path":       os.Getenv("GITHUB_WORKFLOW_REF"),
+		},
+		"release": map[string]string{
+			"tag":    tag,
+			"branch": release.Branch,
+		},
+	}
+	if eventName := os.Getenv("GITHUB_EVENT_NAME"); eventName != "" {
+		predicate.BuildDefinition.InternalParameters = map[string]any{
+			"github": map[string]string{
+				"event_name":          eventName,
+				"repository_id":       os.Getenv("GITHUB_REPOSITORY_ID"),
+				"repository_owner_id": os.Getenv("GITHUB_REPOSITORY_OWNER_ID"),
+			},
+		}
+	}
+	predicate.BuildDefinition.ResolvedDependencies = []slsaResourceDescriptor{{
+		URI:    fmt.Sprintf("git+%s/%s@refs/tags/%s", serverURL, slug, tag),
+		Digest: map[string]string{"gitCommit": release.SHA},
+	}}
+
+	predicate.RunDetails.Builder.ID = "https://github.com/go-semantic-release/provider-github@" + PVERSION
+	if workflowRef := os.Getenv("GITHUB_WORKFLOW_REF"); workflowRef != "" {
+		predicate.RunDetails.Builder.ID = fmt.Sprintf("%s/%s", serverURL, workflowRef)
+	}
+	if runID := os.Getenv("GITHUB_RUN_ID"); runID != "" {
+		predicate.RunDetails.Metadata.InvocationID = fmt.Sprintf("%s/%s/actions/runs/%s/attempts/%s", serverURL, slug, runID, os.Getenv("GITHUB_RUN_ATTEMPT"))
+	}
+
+	data, err := json.Marshal(statement)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
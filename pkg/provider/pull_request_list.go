@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// isListedInChangelog reports whether the changelog already has an entry for the pull request, either by a reference
+// to its number or by the abbreviated SHA of one of its commits.
+func isListedInChangelog(changelog string, number int, shas []string) bool {
+	if regexp.MustCompile(fmt.Sprintf(`#%d\b`, number)).MatchString(changelog) {
+		return true
+	}
+	for _, sha := range shas {
+		if len(sha) >= 7 && strings.Contains(changelog, sha[:7]) {
+			return true
+		}
+	}
+	return false
+}
+
+// renderPullRequestList renders the merged pull requests between previousTag and sha that are not already part of the
+// changelog as "What's Changed" section.
+func (repo *GitHubRepository) renderPullRequestList(previousTag, sha, changelog string) (string, error) {
+	commits, err := repo.listCommitsBetween(previousTag, sha)
+	if err != nil {
+		return "", err
+	}
+	order := make([]int, 0)
+	entries := make(map[int]string)
+	shas := make(map[int][]string)
+	for _, commit := range commits {
+		pr, err := repo.findCommitPullRequest(commit.GetSHA())
+		if err != nil {
+			return "", err
+		}
+		if pr == nil {
+			continue
+		}
+		if _, ok := entries[pr.GetNumber()]; !ok {
+			order = append(order, pr.GetNumber())
+			entries[pr.GetNumber()] = formatPullRequestEntry(pr)
+		}
+		shas[pr.GetNumber()] = append(shas[pr.GetNumber()], commit.GetSHA())
+	}
+
+	sb := &strings.Builder{}
+	for _, number := range order {
+		if isListedInChangelog(changelog, number, shas[number]) {
+			continue
+		}
+		sb.WriteString(entries[number])
+	}
+	if sb.Len() == 0 {
+		return "", nil
+	}
+	return "## What's Changed\n" + sb.String(), nil
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsListedInChangelog(t *testing.T) {
+	changelog := "* **app:** new feature (#12) (1234567)\n"
+	require.True(t, isListedInChangelog(changelog, 12, nil))
+	require.False(t, isListedInChangelog(changelog, 1, nil))
+	require.True(t, isListedInChangelog(changelog, 3, []string{"deadbeef", "1234567890abcdef"}))
+	require.False(t, isListedInChangelog(changelog, 3, []string{"deadbeef"}))
+}
+
+func TestGithubPullRequestList(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_pull_request_list": "true",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/compare/v1.1.1...deadbeef", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.CommitsComparison{Commits: githubCommits[:4]})
+	})
+	prs := map[string]*github.PullRequest{
+		"abcd": createGithubPullRequest(1, "feat", "alice"),
+		"1111": createGithubPullRequest(2, "docs", "bob"),
+		"dcba": createGithubPullRequest(1, "feat", "alice"),
+	}
+	for _, commit := range githubCommits[:4] {
+		pr := prs[commit.GetSHA()]
+		rec.handle("GET /repos/owner/test-repo/commits/"+commit.GetSHA()+"/pulls", func(w http.ResponseWriter, _ *http.Request) {
+			if pr == nil {
+				_ = json.NewEncoder(w).Encode([]*github.PullRequest{})
+				return
+			}
+			_ = json.NewEncoder(w).Encode([]*github.PullRequest{pr})
+		})
+	}
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "* docs (#2)"})
+	require.NoError(t, err)
+	expected := "* docs (#2)\n\n## What's Changed\n* feat by @alice in https://github.com/owner/test-repo/pull/feat\n"
+	require.Equal(t, expected, rec.lastRelease().GetBody())
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/semrel"
+	"github.com/google/go-github/v66/github"
+)
+
+// listCommitsBetween returns the commits reachable from head but not from base. If base is empty
+// all commits reachable from head are returned.
+func (repo *GitHubRepository) listCommitsBetween(base, head string) ([]*github.RepositoryCommit, error) {
+	allCommits := make([]*github.RepositoryCommit, 0)
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		var commits []*github.RepositoryCommit
+		var resp *github.Response
+		var err error
+		if base == "" {
+			commits, resp, err = repo.client.Repositories.ListCommits(context.Background(), repo.owner, repo.repo, &github.CommitsListOptions{
+				SHA:         head,
+				ListOptions: *opts,
+			})
+		} else {
+			var comparison *github.CommitsComparison
+			comparison, resp, err = repo.client.Repositories.CompareCommits(context.Background(), repo.owner, repo.repo, base, head, opts)
+			if err == nil {
+				commits = comparison.Commits
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		allCommits = append(allCommits, commits...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return allCommits, nil
+}
+
+// listMergedPullRequests returns the merged pull requests associated with the given commits in the order they appear.
+func (repo *GitHubRepository) listMergedPullRequests(commits []*github.RepositoryCommit) ([]*github.PullRequest, error) {
+	seen := make(map[int]bool)
+	pullRequests := make([]*github.PullRequest, 0)
+	for _, commit := range commits {
+		prs, _, err := repo.client.PullRequests.ListPullRequestsWithCommit(context.Background(), repo.owner, repo.repo, commit.GetSHA(), nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, pr := range prs {
+			if pr.MergedAt == nil || seen[pr.GetNumber()] {
+				continue
+			}
+			seen[pr.GetNumber()] = true
+			pullRequests = append(pullRequests, pr)
+		}
+	}
+	return pullRequests, nil
+}
+
+// findCommitPullRequest returns the merged pull request that introduced sha or nil if there is none.
+func (repo *GitHubRepository) findCommitPullRequest(sha string) (*github.PullRequest, error) {
+	prs, _, err := repo.client.PullRequests.ListPullRequestsWithCommit(context.Background(), repo.owner, repo.repo, sha, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, pr := range prs {
+		if pr.MergedAt != nil {
+			return pr, nil
+		}
+	}
+	return nil, nil
+}
+
+// annotatePullRequest adds the pr_* annotations of the pull request that introduced the commit.
+func annotatePullRequest(commit *semrel.RawCommit, pr *github.PullRequest) {
+	labels := make([]string, 0, len(pr.Labels))
+	for _, label := range pr.Labels {
+		labels = append(labels, label.GetName())
+		commit.Annotations["pr_label:"+label.GetName()] = "true"
+	}
+	commit.Annotations["pr_number"] = strconv.Itoa(pr.GetNumber())
+	commit.Annotations["pr_title"] = pr.GetTitle()
+	commit.Annotations["pr_labels"] = strings.Join(labels, ",")
+	commit.Annotations["pr_url"] = pr.GetHTMLURL()
+	if releaseType := releaseTypeFromLabels(labels); releaseType != "" {
+		commit.Annotations["pr_release_type"] = releaseType
+	}
+}
+
+// releaseTypeFromLabels returns the highest release type hinted by the labels semver:major, semver:minor,
+// semver:patch and breaking (or breaking-change).
+func releaseTypeFromLabels(labels []string) string {
+	releaseType := ""
+	rank := map[string]int{"": 0, "patch": 1, "minor": 2, "major": 3}
+	for _, label := range labels {
+		hint := ""
+		switch strings.ToLower(label) {
+		case "semver:major", "breaking", "breaking-change":
+			hint = "major"
+		case "semver:minor":
+			hint = "minor"
+		case "semver:patch":
+			hint = "patch"
+		}
+		if rank[hint] > rank[releaseType] {
+			releaseType = hint
+		}
+	}
+	return releaseType
+}
+
+// enrichCommitFromPullRequest looks up the pull request of the commit and adds its metadata as configured.
+func (repo *GitHubRepository) enrichCommitFromPullRequest(commit *semrel.RawCommit) error {
+	pr, err := repo.findCommitPullRequest(commit.SHA)
+	if err != nil {
+		return fmt.Errorf("failed to get pull request of commit %s: %w", commit.SHA, err)
+	}
+	if pr == nil {
+		return nil
+	}
+	if repo.pullRequestAnnotations {
+		annotatePullRequest(commit, pr)
+	}
+	if repo.pullRequestReviews {
+		if err := repo.annotatePullRequestReviews(commit, pr.GetNumber()); err != nil {
+			return err
+		}
+	}
+	// only squash merges turn the pull request into the commit, its body might contain footers the commit message lacks
+	if repo.pullRequestFooters && pr.GetMergeCommitSHA() == commit.SHA {
+		commit.RawMessage = appendFooters(commit.RawMessage, parseFooters(pr.GetBody()))
+	}
+	return nil
+}
+
+// annotatePullRequestReviews adds who merged the pull request (pr_merged_by) and who approved it (pr_approvers).
+func (repo *GitHubRepository) annotatePullRequestReviews(commit *semrel.RawCommit, number int) error {
+	pr, _, err := repo.client.PullRequests.Get(context.Background(), repo.owner, repo.repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get pull request #%d: %w", number, err)
+	}
+	// only the latest review of each reviewer counts
+	latestStates := make(map[string]string)
+	reviewers := make([]string, 0)
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		reviews, resp, err := repo.client.PullRequests.ListReviews(context.Background(), repo.owner, repo.repo, number, opts)
+		if err != nil {
+			return fmt.Errorf("failed to list reviews of pull request #%d: %w", number, err)
+		}
+		for _, review := range reviews {
+			login := review.GetUser().GetLogin()
+			// comments do not change the approval state
+			if login == "" || review.GetState() == "COMMENTED" {
+				continue
+			}
+			if _, ok := latestStates[login]; !ok {
+				reviewers = append(reviewers, login)
+			}
+			latestStates[login] = review.GetState()
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	approvers := make([]string, 0, len(reviewers))
+	for _, login := range reviewers {
+		if latestStates[login] == "APPROVED" {
+			approvers = append(approvers, login)
+		}
+	}
+	commit.Annotations["pr_merged_by"] = pr.GetMergedBy().GetLogin()
+	commit.Annotations["pr_approvers"] = strings.Join(approvers, ",")
+	return nil
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubPullRequestAnnotations(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_pull_request_annotations": "true",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/commits/1111/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		unmerged := createGithubPullRequest(6, "draft", "bob")
+		unmerged.MergedAt = nil
+		_ = json.NewEncoder(w).Encode([]*github.PullRequest{unmerged, createGithubPullRequest(7, "feat", "alice", "feature", "api")})
+	})
+	for _, sha := range []string{"abcd", "dcba", "cdba", "efcd"} {
+		rec.handle("GET /repos/owner/test-repo/commits/"+sha+"/pulls", func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode([]*github.PullRequest{})
+		})
+	}
+
+	commits, err := repo.GetCommits("2222", "1111")
+	require.NoError(t, err)
+	require.Len(t, commits, 5)
+	require.Equal(t, "7", commits[0].Annotations["pr_number"])
+	require.Equal(t, "feat", commits[0].Annotations["pr_title"])
+	require.Equal(t, "feature,api", commits[0].Annotations["pr_labels"])
+	require.Equal(t, "https://github.com/owner/test-repo/pull/feat", commits[0].Annotations["pr_url"])
+	require.Equal(t, "true", commits[0].Annotations["pr_label:api"])
+	require.NotContains(t, commits[0].Annotations, "pr_release_type")
+	require.NotContains(t, commits[1].Annotations, "pr_number")
+}
+
+func TestReleaseTypeFromLabels(t *testing.T) {
+	require.Equal(t, "", releaseTypeFromLabels(nil))
+	require.Equal(t, "", releaseTypeFromLabels([]string{"feature"}))
+	require.Equal(t, "patch", releaseTypeFromLabels([]string{"semver:patch"}))
+	require.Equal(t, "minor", releaseTypeFromLabels([]string{"semver:patch", "semver:minor"}))
+	require.Equal(t, "major", releaseTypeFromLabels([]string{"Breaking", "semver:minor"}))
+}
+
+func TestGithubPullRequestReviews(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_pull_request_reviews": "true",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/commits/1111/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]*github.PullRequest{createGithubPullRequest(7, "feat", "alice")})
+	})
+	for _, sha := range []string{"abcd", "dcba", "cdba", "efcd"} {
+		rec.handle("GET /repos/owner/test-repo/commits/"+sha+"/pulls", func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode([]*github.PullRequest{})
+		})
+	}
+	rec.handle("GET /repos/owner/test-repo/pulls/7", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"number": 7, "merged_by": {"login": "carol"}}`)
+	})
+	rec.handle("GET /repos/owner/test-repo/pulls/7/reviews", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[
+			{"user": {"login": "bob"}, "state": "CHANGES_REQUESTED"},
+			{"user": {"login": "dave"}, "state": "APPROVED"},
+			{"user": {"login": "bob"}, "state": "APPROVED"},
+			{"user": {"login": "bob"}, "state": "COMMENTED"},
+			{"user": {"login": "erin"}, "state": "APPROVED"},
+			{"user": {"login": "erin"}, "state": "DISMISSED"}
+		]`)
+	})
+
+	commits, err := repo.GetCommits("2222", "1111")
+	require.NoError(t, err)
+	require.Equal(t, "carol", commits[0].Annotations["pr_merged_by"])
+	require.Equal(t, "bob,dave", commits[0].Annotations["pr_approvers"])
+	require.NotContains(t, commits[0].Annotations, "pr_number")
+	require.NotContains(t, commits[1].Annotations, "pr_merged_by")
+}
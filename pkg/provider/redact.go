@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/semrel"
+)
+
+const (
+	redactEmailsOmit = "omit"
+	redactEmailsHash = "hash"
+)
+
+// redactEmails removes or hashes the author and committer emails of the commit annotations.
+func redactEmails(commit *semrel.RawCommit, mode string) {
+	for _, key := range []string{"author_email", "committer_email"} {
+		switch mode {
+		case redactEmailsOmit:
+			delete(commit.Annotations, key)
+		case redactEmailsHash:
+			sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(commit.Annotations[key]))))
+			commit.Annotations[key] = hex.EncodeToString(sum[:])
+		}
+	}
+}
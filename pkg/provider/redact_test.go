@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubRedactEmails(t *testing.T) {
+	for _, mode := range []string{"omit", "hash"} {
+		t.Run(mode, func(t *testing.T) {
+			repo, ts, _ := getNewGithubRecordingTestRepo(t, map[string]string{
+				"github_redact_emails": mode,
+			})
+			defer ts.Close()
+
+			commits, err := repo.GetCommits("2222", "1111")
+			require.NoError(t, err)
+			for _, c := range commits {
+				require.Equal(t, githubAuthorLogin, c.Annotations["author_login"])
+				if mode == "omit" {
+					require.NotContains(t, c.Annotations, "author_email")
+					require.NotContains(t, c.Annotations, "committer_email")
+					continue
+				}
+				// sha256 of author@github.com
+				require.Equal(t, "a06d45e6cc964c5e8e9b16adc72c0c23e6465ebfd0a3f1db861cce51d2c390cc", c.Annotations["author_email"])
+				require.Equal(t, c.Annotations["author_email"], c.Annotations["committer_email"])
+			}
+		})
+	}
+}
+
+func TestGithubInvalidRedactEmails(t *testing.T) {
+	repo := &GitHubRepository{}
+	err := repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_redact_emails": "mask"})
+	require.ErrorContains(t, err, "invalid value for github_redact_emails")
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// releaseCreated logs the created release, remembers it for ReleaseAnnotations and sets the GitHub Actions outputs.
+func (repo *GitHubRepository) releaseCreated(version, tag, body string, release *github.RepositoryRelease) error {
+	status := "published"
+	if release.GetDraft() {
+		status = "created draft"
+	}
+	log.Printf("%s release %s (ID %d): %s", status, tag, release.GetID(), release.GetHTMLURL())
+	repo.releaseAnnotations = map[string]string{
+		"release_id":  strconv.FormatInt(release.GetID(), 10),
+		"release_url": release.GetHTMLURL(),
+		"release_tag": tag,
+	}
+	return writeActionsOutputs(version, tag, body, release)
+}
+
+// ReleaseAnnotations returns the ID, URL and tag of the release created by the last CreateRelease call, so that
+// follow-up steps can link to the published release.
+func (repo *GitHubRepository) ReleaseAnnotations() map[string]string {
+	annotations := make(map[string]string, len(repo.releaseAnnotations))
+	for k, v := range repo.releaseAnnotations {
+		annotations[k] = v
+	}
+	return annotations
+}
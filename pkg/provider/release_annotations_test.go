@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubReleaseAnnotations(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+	require.Empty(t, repo.ReleaseAnnotations())
+	rec.handle("POST /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.RepositoryRelease{
+			ID:      github.Int64(42),
+			HTMLURL: github.String("https://github.com/owner/test-repo/releases/tag/v2.0.0"),
+		})
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"release_id":  "42",
+		"release_url": "https://github.com/owner/test-repo/releases/tag/v2.0.0",
+		"release_tag": "v2.0.0",
+	}, repo.ReleaseAnnotations())
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// releaseAsset is a local file that is uploaded as release asset.
+type releaseAsset struct {
+	name string
+	path string
+	// tempDir is removed after the release, e.g. the download directory of a remote asset
+	tempDir string
+	// format is the archive format the asset is packaged in before the upload, e.g. of dist/app_*:zip
+	format string
+}
+
+// removeTemporaryAssets removes the temporary directories of the assets.
+func removeTemporaryAssets(assets []*releaseAsset) {
+	for _, asset := range assets {
+		if asset.tempDir != "" {
+			os.RemoveAll(asset.tempDir)
+		}
+	}
+}
+
+// collectAssets resolves the github_assets globs, downloads the remote assets and verifies the files against their
+// checksums before anything is released, then the assets with a format are packaged and the archives are created. The
+// checksums file and the checksum manifest are skipped, the checksums file is uploaded on its own. The temporary files
+// of the assets have to be removed with removeTemporaryAssets.
+func (repo *GitHubRepository) collectAssets(version, tag string) (assets []*releaseAsset, err error) {
+	assets = make([]*releaseAsset, 0)
+	defer func() {
+		if err != nil {
+			removeTemporaryAssets(assets)
+		}
+	}()
+	paths := make(map[string]string)
+	add := func(asset *releaseAsset) error {
+		if other, ok := paths[asset.name]; ok {
+			if isSameFile(other, asset.path) {
+				return nil
+			}
+			return fmt.Errorf("duplicate asset name %s (%s and %s)", asset.name, other, asset.path)
+		}
+		paths[asset.name] = asset.path
+		assets = append(assets, asset)
+		return nil
+	}
+	patterns := make([]string, 0, len(repo.assetFiles))
+	for _, entry := range repo.assetFiles {
+		source, format := splitAssetFormat(entry)
+		if isRemoteAsset(source) {
+			asset, err := repo.downloadAsset(source)
+			if err != nil {
+				return assets, err
+			}
+			asset.format = format
+			if err := add(asset); err != nil {
+				removeTemporaryAssets([]*releaseAsset{asset})
+				return assets, err
+			}
+			continue
+		}
+		patterns = append(patterns, entry)
+	}
+	for _, entry := range patterns {
+		pattern, format := splitAssetFormat(entry)
+		files, err := globFiles([]string{pattern})
+		if err != nil {
+			return assets, err
+		}
+		for _, file := range files {
+			if isSameFile(file, repo.checksumsFile) || isSameFile(file, repo.assetsManifest) {
+				continue
+			}
+			info, err := os.Stat(file)
+			if err != nil {
+				return assets, fmt.Errorf("failed to read asset %s: %w", file, err)
+			}
+			if info.IsDir() {
+				continue
+			}
+			if err := add(&releaseAsset{name: filepath.Base(file), path: file, format: format}); err != nil {
+				return assets, err
+			}
+		}
+	}
+	if err := repo.verifyAssetChecksums(assets); err != nil {
+		return assets, err
+	}
+	for i, asset := range assets {
+		if asset.format == "" {
+			continue
+		}
+		archive, err := packageAsset(asset, asset.format)
+		if err != nil {
+			return assets, err
+		}
+		assets[i] = archive
+	}
+	archives, err := repo.createAssetArchives(version, tag)
+	if err != nil {
+		return assets, err
+	}
+	assets = append(assets, archives...)
+	names := make(map[string]bool, len(assets))
+	for _, asset := range assets {
+		if names[asset.name] {
+			return assets, fmt.Errorf("duplicate asset name %s", asset.name)
+		}
+		names[asset.name] = true
+	}
+	checked, err := repo.checkAssetSizes(assets)
+	if err != nil {
+		return assets, err
+	}
+	return checked, nil
+}
+
+func (repo *GitHubRepository) uploadFileAssets(releaseID int64, assets []*releaseAsset) ([]*uploadedAsset, error) {
+	uploaded := make([]*uploadedAsset, 0, len(assets))
+	for _, asset := range assets {
+		f, err := os.Open(asset.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read asset %s: %w", asset.path, err)
+		}
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to read asset %s: %w", asset.path, err)
+		}
+		result, err := repo.uploadReleaseAsset(releaseID, asset.name, f, info.Size())
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		uploaded = append(uploaded, result)
+	}
+	return uploaded, nil
+}
+
+func isSameFile(path, other string) bool {
+	return other != "" && filepath.Clean(path) == filepath.Clean(other)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubUploadAssets(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app_linux_amd64"), []byte("linux"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app_darwin_arm64"), []byte("darwin"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "tmp"), 0o700))
+
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_assets": filepath.Join(dir, "*") + "," + filepath.Join(dir, "app_linux_amd64"),
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+
+	data, ok := rec.get("app_linux_amd64")
+	require.True(t, ok)
+	require.Equal(t, []byte("linux"), data)
+	data, ok = rec.get("app_darwin_arm64")
+	require.True(t, ok)
+	require.Equal(t, []byte("darwin"), data)
+	_, ok = rec.get("tmp")
+	require.False(t, ok)
+}
+
+func TestGithubCollectAssetsDuplicateName(t *testing.T) {
+	dir := t.TempDir()
+	for _, sub := range []string{"a", "b"} {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, sub), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, sub, "app"), []byte(sub), 0o600))
+	}
+	repo := &GitHubRepository{assetFiles: []string{filepath.Join(dir, "*", "app")}}
+	_, err := repo.collectAssets("2.0.0", "v2.0.0")
+	require.ErrorContains(t, err, "duplicate asset name app")
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+	"gopkg.in/yaml.v3"
+)
+
+const generateReleaseNotesClient = "client"
+
+type releaseNotesExclude struct {
+	Labels  []string `yaml:"labels"`
+	Authors []string `yaml:"authors"`
+}
+
+type releaseNotesCategory struct {
+	Title   string              `yaml:"title"`
+	Labels  []string            `yaml:"labels"`
+	Exclude releaseNotesExclude `yaml:"exclude"`
+}
+
+// releaseNotesConfig mirrors the .github/release.yml configuration of GitHub's generated release notes.
+type releaseNotesConfig struct {
+	Changelog struct {
+		Exclude    releaseNotesExclude    `yaml:"exclude"`
+		Categories []releaseNotesCategory `yaml:"categories"`
+	} `yaml:"changelog"`
+}
+
+func (e releaseNotesExclude) matches(pr *github.PullRequest) bool {
+	if slices.Contains(e.Authors, pr.GetUser().GetLogin()) {
+		return true
+	}
+	for _, label := range pr.Labels {
+		if slices.Contains(e.Labels, label.GetName()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c releaseNotesCategory) matches(pr *github.PullRequest) bool {
+	if c.Exclude.matches(pr) {
+		return false
+	}
+	if slices.Contains(c.Labels, "*") {
+		return true
+	}
+	for _, label := range pr.Labels {
+		if slices.Contains(c.Labels, label.GetName()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (repo *GitHubRepository) loadReleaseNotesConfig(ref string) (*releaseNotesConfig, error) {
+	config := &releaseNotesConfig{}
+	for _, file := range []string{".github/release.yml", ".github/release.yaml"} {
+		content, _, resp, err := repo.client.Repositories.GetContents(context.Background(), repo.owner, repo.repo, file, &github.RepositoryContentGetOptions{Ref: ref})
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		raw, err := content.GetContent()
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal([]byte(raw), config); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		return config, nil
+	}
+	return config, nil
+}
+
+func formatPullRequestEntry(pr *github.PullRequest) string {
+	return fmt.Sprintf("* %s by @%s in %s\n", pr.GetTitle(), pr.GetUser().GetLogin(), pr.GetHTMLURL())
+}
+
+// renderCategorizedReleaseNotes groups the pull requests into the configured categories like GitHub's generated release notes.
+func renderCategorizedReleaseNotes(config *releaseNotesConfig, pullRequests []*github.PullRequest) string {
+	categories := config.Changelog.Categories
+	grouped := make([][]*github.PullRequest, len(categories))
+	uncategorized := make([]*github.PullRequest, 0)
+	for _, pr := range pullRequests {
+		if config.Changelog.Exclude.matches(pr) {
+			continue
+		}
+		if len(categories) == 0 {
+			uncategorized = append(uncategorized, pr)
+			continue
+		}
+		// pull requests without a matching category are omitted, a "*" category acts as catch-all
+		idx := slices.IndexFunc(categories, func(c releaseNotesCategory) bool { return c.matches(pr) })
+		if idx >= 0 {
+			grouped[idx] = append(grouped[idx], pr)
+		}
+	}
+
+	sb := &strings.Builder{}
+	for i, category := range categories {
+		if len(grouped[i]) == 0 {
+			continue
+		}
+		fmt.Fprintf(sb, "### %s\n", category.Title)
+		for _, pr := range grouped[i] {
+			sb.WriteString(formatPullRequestEntry(pr))
+		}
+	}
+	for _, pr := range uncategorized {
+		sb.WriteString(formatPullRequestEntry(pr))
+	}
+	if sb.Len() == 0 {
+		return ""
+	}
+	return "## What's Changed\n" + sb.String()
+}
+
+func (repo *GitHubRepository) generateClientReleaseNotes(previousTag, sha, branch string) (string, error) {
+	config, err := repo.loadReleaseNotesConfig(branch)
+	if err != nil {
+		return "", err
+	}
+	commits, err := repo.listCommitsBetween(previousTag, sha)
+	if err != nil {
+		return "", err
+	}
+	pullRequests, err := repo.listMergedPullRequests(commits)
+	if err != nil {
+		return "", err
+	}
+	return renderCategorizedReleaseNotes(config, pullRequests), nil
+}
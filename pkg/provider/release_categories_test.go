@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func createGithubPullRequest(number int, title, author string, labels ...string) *github.PullRequest {
+	pr := &github.PullRequest{
+		Number:   github.Int(number),
+		Title:    github.String(title),
+		User:     &github.User{Login: github.String(author)},
+		HTMLURL:  github.String("https://github.com/owner/test-repo/pull/" + title),
+		MergedAt: &github.Timestamp{Time: time.Now()},
+	}
+	for _, label := range labels {
+		pr.Labels = append(pr.Labels, &github.Label{Name: github.String(label)})
+	}
+	return pr
+}
+
+const testReleaseNotesConfig = `
+changelog:
+  exclude:
+    labels: [ignore-for-release]
+    authors: [dependabot]
+  categories:
+    - title: Breaking Changes
+      labels: [breaking-change]
+    - title: Features
+      labels: [feature]
+      exclude:
+        labels: [internal]
+    - title: Other Changes
+      labels: ["*"]
+`
+
+func TestRenderCategorizedReleaseNotes(t *testing.T) {
+	config := &releaseNotesConfig{}
+	require.NoError(t, yaml.Unmarshal([]byte(testReleaseNotesConfig), config))
+
+	prs := []*github.PullRequest{
+		createGithubPullRequest(1, "feat", "alice", "feature"),
+		createGithubPullRequest(2, "break", "bob", "breaking-change", "feature"),
+		createGithubPullRequest(3, "internal", "alice", "feature", "internal"),
+		createGithubPullRequest(4, "deps", "dependabot"),
+		createGithubPullRequest(5, "ignored", "bob", "ignore-for-release"),
+	}
+	expected := "## What's Changed\n" +
+		"### Breaking Changes\n" +
+		"* break by @bob in https://github.com/owner/test-repo/pull/break\n" +
+		"### Features\n" +
+		"* feat by @alice in https://github.com/owner/test-repo/pull/feat\n" +
+		"### Other Changes\n" +
+		"* internal by @alice in https://github.com/owner/test-repo/pull/internal\n"
+	require.Equal(t, expected, renderCategorizedReleaseNotes(config, prs))
+
+	require.Equal(t, "## What's Changed\n* deps by @dependabot in https://github.com/owner/test-repo/pull/deps\n",
+		renderCategorizedReleaseNotes(&releaseNotesConfig{}, prs[3:4]))
+	require.Equal(t, "", renderCategorizedReleaseNotes(config, prs[3:]))
+}
+
+func TestGithubClientReleaseNotes(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_generate_release_notes": "client",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/contents/.github/release.yml", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"type":     "file",
+			"encoding": "base64",
+			"content":  base64.StdEncoding.EncodeToString([]byte(testReleaseNotesConfig)),
+		})
+	})
+	rec.handle("GET /repos/owner/test-repo/compare/v1.1.1...deadbeef", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.CommitsComparison{Commits: githubCommits[:2]})
+	})
+	rec.handle("GET /repos/owner/test-repo/commits/abcd/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]*github.PullRequest{createGithubPullRequest(1, "feat", "alice", "feature")})
+	})
+	rec.handle("GET /repos/owner/test-repo/commits/1111/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]*github.PullRequest{createGithubPullRequest(1, "feat", "alice", "feature")})
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog"})
+	require.NoError(t, err)
+	expected := "changelog\n\n## What's Changed\n### Features\n* feat by @alice in https://github.com/owner/test-repo/pull/feat\n"
+	require.Equal(t, expected, rec.lastRelease().GetBody())
+}
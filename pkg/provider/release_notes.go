@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+)
+
+const (
+	generateReleaseNotesServer = "true"
+	generateReleaseNotesAppend = "append"
+)
+
+func (repo *GitHubRepository) listTags() ([]string, error) {
+	tags := make([]string, 0)
+	opts := &github.ReferenceListOptions{Ref: repo.tagsRef(), ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		refs, resp, err := repo.client.Git.ListMatchingRefs(context.Background(), repo.tagOwner, repo.tagRepo, opts)
+		if resp != nil && resp.StatusCode == 404 || isEmptyRepository(resp) {
+			return tags, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range refs {
+			tag := strings.TrimPrefix(r.GetRef(), "refs/tags/")
+			if repo.isExcludedTag(tag) {
+				continue
+			}
+			tags = append(tags, tag)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return tags, nil
+}
+
+// findPreviousTag returns the tag of the highest version lower than newVersion. Prereleases are
+// only considered if the new version is a prerelease as well.
+func (repo *GitHubRepository) findPreviousTag(newVersion string) (string, error) {
+	current, err := semver.NewVersion(newVersion)
+	if err != nil {
+		return "", err
+	}
+	tags, err := repo.listTags()
+	if err != nil {
+		return "", err
+	}
+	var previous *semver.Version
+	previousTag := ""
+	for _, tag := range tags {
+		version, err := repo.parseTagVersion(tag)
+		if err != nil || !version.LessThan(current) {
+			continue
+		}
+		if version.Prerelease() != "" && current.Prerelease() == "" {
+			continue
+		}
+		if previous == nil || version.GreaterThan(previous) {
+			previous = version
+			previousTag = tag
+		}
+	}
+	return previousTag, nil
+}
+
+func (repo *GitHubRepository) fetchGeneratedReleaseNotes(tag, previousTag, target string) (string, error) {
+	opts := &github.GenerateNotesOptions{TagName: tag}
+	if previousTag != "" {
+		opts.PreviousTagName = &previousTag
+	}
+	if target != "" {
+		opts.TargetCommitish = &target
+	}
+	notes, _, err := repo.client.Repositories.GenerateReleaseNotes(context.Background(), repo.tagOwner, repo.tagRepo, opts)
+	if err != nil {
+		return "", err
+	}
+	return notes.Body, nil
+}
+
+// appendSection appends a markdown section separated by an empty line.
+func appendSection(body, section string) string {
+	if section == "" {
+		return body
+	}
+	if strings.TrimSpace(body) == "" {
+		return section
+	}
+	return strings.TrimRight(body, "\n") + "\n\n" + section
+}
+
+// releaseBody builds the body of the GitHub release from the changelog.
+func (repo *GitHubRepository) releaseBody(release *provider.CreateReleaseConfig, tag string) (string, error) {
+	previousTag := ""
+	if repo.generateReleaseNotes == generateReleaseNotesAppend || repo.generateReleaseNotes == generateReleaseNotesClient ||
+		repo.releaseBodyTemplate != nil || repo.fullChangelogLink || repo.contributors ||
+		repo.pullRequestList {
+		var err error
+		previousTag, err = repo.findPreviousTag(release.NewVersion)
+		if err != nil {
+			return "", fmt.Errorf("failed to find previous tag: %w", err)
+		}
+	}
+
+	body := release.Changelog
+	switch repo.generateReleaseNotes {
+	case generateReleaseNotesAppend:
+		notes, err := repo.fetchGeneratedReleaseNotes(tag, previousTag, release.Branch)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate release notes: %w", err)
+		}
+		body = appendSection(body, notes)
+	case generateReleaseNotesClient:
+		notes, err := repo.generateClientReleaseNotes(previousTag, release.SHA, release.Branch)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate release notes: %w", err)
+		}
+		body = appendSection(body, notes)
+	}
+	if repo.pullRequestList && !strings.Contains(body, "## What's Changed") {
+		list, err := repo.renderPullRequestList(previousTag, release.SHA, release.Changelog)
+		if err != nil {
+			return "", fmt.Errorf("failed to list pull requests: %w", err)
+		}
+		body = appendSection(body, list)
+	}
+	if repo.releaseBodyTemplate != nil {
+		var err error
+		body, err = repo.renderReleaseBody(release, tag, previousTag, body)
+		if err != nil {
+			return "", err
+		}
+	}
+	if repo.contributors {
+		contributors, err := repo.contributorsSection(previousTag, release.SHA)
+		if err != nil {
+			return "", fmt.Errorf("failed to list contributors: %w", err)
+		}
+		body = appendSection(body, contributors)
+	}
+	if repo.collapseThreshold > 0 {
+		body = collapseSections(body, repo.collapseThreshold)
+	}
+	if repo.fullChangelogLink && previousTag != "" && !strings.Contains(body, "**Full Changelog**") {
+		body = appendSection(body, fmt.Sprintf("**Full Changelog**: %s", repo.compareURL(previousTag, tag)))
+	}
+	if repo.displayNames != "" {
+		body = repo.resolveDisplayNames(body)
+	}
+	if repo.mentions != "" {
+		body = handleMentions(body, repo.mentions)
+	}
+	if repo.autolinkReferences {
+		body = repo.autolink(body)
+	}
+	body = appendSection(body, teamMentions(repo.notifyTeams))
+	body = appendSection(repo.changelogHeader, body)
+	body = appendSection(body, repo.changelogFooter)
+	return body, nil
+}
+
+// readInlineOrFile returns the content of the file if value is a path to an existing file, otherwise value itself.
+func readInlineOrFile(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	info, err := os.Stat(value)
+	if err != nil || info.IsDir() {
+		return value, nil
+	}
+	data, err := os.ReadFile(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubFindPreviousTag(t *testing.T) {
+	repo, ts := getNewGithubTestRepo(t)
+	defer ts.Close()
+
+	testCases := []struct {
+		version     string
+		previousTag string
+	}{
+		{"2.0.1", "v2.0.0"},
+		{"2.0.0", "v1.1.1"},
+		{"3.0.0", "v2.0.0"},
+		{"3.0.0-beta.3", "v3.0.0-beta.2"},
+		{"1.0.0", ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.version, func(t *testing.T) {
+			previousTag, err := repo.findPreviousTag(tc.version)
+			require.NoError(t, err)
+			require.Equal(t, tc.previousTag, previousTag)
+		})
+	}
+}
+
+func TestGithubGenerateReleaseNotes(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_generate_release_notes": "true",
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog"})
+	require.NoError(t, err)
+	require.True(t, rec.lastRelease().GetGenerateReleaseNotes())
+	require.Equal(t, "changelog", rec.lastRelease().GetBody())
+}
+
+func TestGithubAppendGeneratedReleaseNotes(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_generate_release_notes": "append",
+	})
+	defer ts.Close()
+	rec.handle("POST /repos/owner/test-repo/releases/generate-notes", func(w http.ResponseWriter, r *http.Request) {
+		opts := &github.GenerateNotesOptions{}
+		if err := json.NewDecoder(r.Body).Decode(opts); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, `{"name": %q, "body": "**Full Changelog**: %s...%s"}`, opts.TagName, opts.GetPreviousTagName(), opts.TagName)
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog\n"})
+	require.NoError(t, err)
+	require.False(t, rec.lastRelease().GetGenerateReleaseNotes())
+	require.Equal(t, "changelog\n\n**Full Changelog**: v1.1.1...v2.0.0", rec.lastRelease().GetBody())
+}
+
+func TestGithubInvalidGenerateReleaseNotes(t *testing.T) {
+	repo := &GitHubRepository{}
+	err := repo.Init(map[string]string{
+		"slug":                          "owner/test-repo",
+		"token":                         "token",
+		"github_generate_release_notes": "prepend",
+	})
+	require.ErrorContains(t, err, "invalid value for github_generate_release_notes")
+}
+
+func TestGithubChangelogHeaderFooter(t *testing.T) {
+	footerFile := filepath.Join(t.TempDir(), "footer.md")
+	require.NoError(t, os.WriteFile(footerFile, []byte("See SUPPORT.md\n"), 0o600))
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"changelog_header": "![downloads](https://img.shields.io/github/downloads/owner/test-repo/total)",
+		"changelog_footer": footerFile,
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog\n"})
+	require.NoError(t, err)
+	expected := "![downloads](https://img.shields.io/github/downloads/owner/test-repo/total)\n\nchangelog\n\nSee SUPPORT.md\n"
+	require.Equal(t, expected, rec.lastRelease().GetBody())
+}
+
+func TestGithubFullChangelogLink(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_full_changelog_link": "true",
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog"})
+	require.NoError(t, err)
+	expected := "changelog\n\n**Full Changelog**: " + repo.repoURL() + "/compare/v1.1.1...v2.0.0"
+	require.Equal(t, expected, rec.lastRelease().GetBody())
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubReleaseSlug(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_release_slug": "owner/dist-repo"})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/dist-repo/releases/tags/v2.0.0", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+	rec.handle("GET /repos/owner/dist-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "[]")
+	})
+	var created map[string]interface{}
+	rec.handle("POST /repos/owner/dist-repo/releases", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+		_ = json.NewEncoder(w).Encode(github.RepositoryRelease{ID: github.Int64(1)})
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "main"})
+	require.NoError(t, err)
+	require.Equal(t, "v2.0.0", created["tag_name"])
+	require.NotContains(t, created, "target_commitish")
+	require.Nil(t, rec.lastRelease())
+}
+
+func TestGithubInvalidReleaseSlug(t *testing.T) {
+	for _, slug := range []string{"owner", "owner/", "/repo"} {
+		err := (&GitHubRepository{}).Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_release_slug": slug})
+		require.EqualError(t, err, fmt.Sprintf("invalid github_release_slug: %s", slug))
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/semrel"
+	"github.com/google/go-github/v66/github"
+)
+
+var commitSHARe = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// taggedRelease is a release found by GetReleases together with the tag it was found by.
+type taggedRelease struct {
+	tag     string
+	release *semrel.Release
+}
+
+// listGitHubReleases returns all GitHub releases (including drafts) by their tag name.
+func (repo *GitHubRepository) listGitHubReleases() (map[string]*github.RepositoryRelease, error) {
+	releases := make(map[string]*github.RepositoryRelease)
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := repo.client.Repositories.ListReleases(context.Background(), repo.releaseOwner, repo.releaseRepo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list releases: %w", err)
+		}
+		for _, r := range page {
+			releases[r.GetTagName()] = r
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return releases, nil
+}
+
+// isSkippedRelease reports whether the tag belongs to a draft or prerelease GitHub release that should be ignored.
+func (repo *GitHubRepository) isSkippedRelease(githubReleases map[string]*github.RepositoryRelease, tag string) bool {
+	r, ok := githubReleases[tag]
+	if !ok {
+		return false
+	}
+	return (repo.skipDraftReleases && r.GetDraft()) || (repo.skipPrereleaseReleases && r.GetPrerelease())
+}
+
+// resolveReleasesWithoutTags returns the published GitHub releases whose tags were deleted, they are resolved
+// through their target_commitish if it is a commit SHA (a branch does not identify the released commit).
+func (repo *GitHubRepository) resolveReleasesWithoutTags(githubReleases map[string]*github.RepositoryRelease, seenTags, reachableCache map[string]bool, re *regexp.Regexp, rawRe string) ([]*taggedRelease, error) {
+	tags := make([]string, 0)
+	for tag, r := range githubReleases {
+		if seenTags[tag] || r.GetDraft() || !commitSHARe.MatchString(r.GetTargetCommitish()) {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	releases := make([]*taggedRelease, 0)
+	for _, tag := range tags {
+		if (rawRe != "" && !re.MatchString(tag)) || repo.isExcludedTag(tag) || repo.isSkippedRelease(githubReleases, tag) {
+			continue
+		}
+		version, err := repo.parseTagVersion(tag)
+		if err != nil {
+			continue
+		}
+		sha := githubReleases[tag].GetTargetCommitish()
+		if repo.releasesBranch != "" {
+			reachable, err := repo.isReachableFrom(sha, repo.releasesBranch, reachableCache)
+			if err != nil {
+				return nil, err
+			}
+			if !reachable {
+				continue
+			}
+		}
+		releases = append(releases, &taggedRelease{tag: tag, release: &semrel.Release{SHA: sha, Version: version.String()}})
+	}
+	return releases, nil
+}
+
+// dedupeReleases drops releases of the same version found by equivalent tags (e.g. v1.2.3 and 1.2.3). The release
+// of the tag that CreateRelease would create wins, otherwise the first one.
+func (repo *GitHubRepository) dedupeReleases(releases []*taggedRelease) []*semrel.Release {
+	selected := make(map[string]*taggedRelease)
+	versions := make([]string, 0, len(releases))
+	for _, r := range releases {
+		version := r.release.Version
+		existing, ok := selected[version]
+		if !ok {
+			selected[version] = r
+			versions = append(versions, version)
+			continue
+		}
+		if r.tag == repo.formatTag(version) && existing.tag != r.tag {
+			selected[version] = r
+		}
+		if existing.release.SHA != r.release.SHA {
+			log.Printf("warning: the tags %s and %s both describe version %s but point at different commits, using %s", existing.tag, r.tag, version, selected[version].tag)
+			continue
+		}
+		log.Printf("the tags %s and %s both describe version %s, using %s", existing.tag, r.tag, version, selected[version].tag)
+	}
+	deduped := make([]*semrel.Release, 0, len(versions))
+	for _, version := range versions {
+		deduped = append(deduped, selected[version].release)
+	}
+	return deduped
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubGetReleasesSkipReleases(t *testing.T) {
+	testCases := []struct {
+		config   map[string]string
+		versions []string
+	}{
+		{map[string]string{}, []string{"1.0.0", "2.0.0", "1.1.1"}},
+		{map[string]string{"github_releases_skip_drafts": "true"}, []string{"1.0.0", "1.1.1"}},
+		{map[string]string{"github_releases_skip_prereleases": "true"}, []string{"2.0.0", "1.1.1"}},
+	}
+	for _, tc := range testCases {
+		repo, ts, rec := getNewGithubRecordingTestRepo(t, tc.config)
+		rec.handle("GET /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `[{"tag_name": "v2.0.0", "draft": true}, {"tag_name": "v1.0.0", "prerelease": true}, {"tag_name": "v1.1.1"}]`)
+		})
+		releases, err := repo.GetReleases("^v\\d+\\.\\d+\\.\\d+$")
+		require.NoError(t, err)
+		versions := make([]string, 0, len(releases))
+		for _, r := range releases {
+			versions = append(versions, r.Version)
+		}
+		require.Equal(t, tc.versions, versions)
+		ts.Close()
+	}
+}
+
+func TestGithubGetReleasesWithoutTags(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_releases_without_tags": "true",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[
+			{"tag_name": "v0.9.0", "target_commitish": "0123456789abcdef0123456789abcdef01234567"},
+			{"tag_name": "v0.8.0", "target_commitish": "master"},
+			{"tag_name": "v0.7.0", "target_commitish": "89abcdef0123456789abcdef0123456789abcdef", "draft": true},
+			{"tag_name": "v2.0.0", "target_commitish": "0123456789abcdef0123456789abcdef01234567"}
+		]`)
+	})
+	releases, err := repo.GetReleases("^v\\d+\\.\\d+\\.\\d+$")
+	require.NoError(t, err)
+	require.Len(t, releases, 4)
+	require.Equal(t, "0.9.0", releases[3].Version)
+	require.Equal(t, "0123456789abcdef0123456789abcdef01234567", releases[3].SHA)
+}
+
+func TestGithubGetReleasesDedupe(t *testing.T) {
+	logs := &bytes.Buffer{}
+	log.SetOutput(logs)
+	defer log.SetOutput(os.Stderr)
+	for _, stripV := range []bool{false, true} {
+		logs.Reset()
+		config := map[string]string{}
+		expectedSHA, prefix := "b", "v"
+		if stripV {
+			config["strip_v_tag_prefix"] = "true"
+			expectedSHA, prefix = "a", ""
+		}
+		repo, ts, rec := getNewGithubRecordingTestRepo(t, config)
+		rec.handle("GET /repos/owner/test-repo/git/matching-refs/tags", func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `[
+				{"ref": "refs/tags/1.2.3", "object": {"type": "commit", "sha": "a"}},
+				{"ref": "refs/tags/v1.2.3", "object": {"type": "commit", "sha": "b"}},
+				{"ref": "refs/tags/v1.3.0", "object": {"type": "commit", "sha": "c"}},
+				{"ref": "refs/tags/1.3.0", "object": {"type": "commit", "sha": "c"}}
+			]`)
+		})
+		releases, err := repo.GetReleases("")
+		require.NoError(t, err)
+		require.Len(t, releases, 2)
+		require.Equal(t, "1.2.3", releases[0].Version)
+		require.Equal(t, expectedSHA, releases[0].SHA)
+		require.Equal(t, "1.3.0", releases[1].Version)
+		require.Contains(t, logs.String(), "warning: the tags 1.2.3 and v1.2.3 both describe version 1.2.3 but point at different commits, using "+prefix+"1.2.3\n")
+		require.Contains(t, logs.String(), "the tags v1.3.0 and 1.3.0 both describe version 1.3.0, using "+prefix+"1.3.0\n")
+		ts.Close()
+	}
+}
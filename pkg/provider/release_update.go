@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// findExistingRelease returns the release for tag if it was already created by a previous (failed) run.
+func (repo *GitHubRepository) findExistingRelease(tag string) (*github.RepositoryRelease, error) {
+	release, resp, err := repo.client.Repositories.GetReleaseByTag(context.Background(), repo.releaseOwner, repo.releaseRepo, tag)
+	if err == nil {
+		return release, nil
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		return nil, err
+	}
+	// draft releases can not be found by their tag
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		releases, resp, err := repo.client.Repositories.ListReleases(context.Background(), repo.releaseOwner, repo.releaseRepo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range releases {
+			if r.GetDraft() && r.GetTagName() == tag {
+				return r, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// deleteReleaseAssetByName deletes the asset with the given name so that it can be uploaded again.
+func (repo *GitHubRepository) deleteReleaseAssetByName(releaseID int64, name string) error {
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		assets, resp, err := repo.client.Repositories.ListReleaseAssets(context.Background(), repo.releaseOwner, repo.releaseRepo, releaseID, opts)
+		if err != nil {
+			return err
+		}
+		for _, asset := range assets {
+			if asset.GetName() == name {
+				_, err := repo.client.Repositories.DeleteReleaseAsset(context.Background(), repo.releaseOwner, repo.releaseRepo, asset.GetID())
+				return err
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
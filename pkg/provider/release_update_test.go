@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubUpdateExistingRelease(t *testing.T) {
+	checksumsFile := filepath.Join(t.TempDir(), "checksums.txt")
+	require.NoError(t, os.WriteFile(checksumsFile, []byte("checksums"), 0o600))
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_checksums_file": checksumsFile,
+	})
+	defer ts.Close()
+
+	// the draft is not on the first page of a repository with many releases
+	rec.handle("GET /repos/owner/test-repo/releases", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", `<https://api.github.com/repos/owner/test-repo/releases?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id": 41, "tag_name": "v1.0.0"}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"id": 42, "tag_name": "v2.0.0", "draft": true}]`)
+	})
+	rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("tag must not be created again")
+		http.Error(w, "already exists", http.StatusUnprocessableEntity)
+	})
+	var updated *github.RepositoryRelease
+	rec.handle("PATCH /repos/owner/test-repo/releases/42", func(w http.ResponseWriter, r *http.Request) {
+		updated = &github.RepositoryRelease{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(updated))
+		fmt.Fprint(w, `{"id": 42}`)
+	})
+	uploads := 0
+	rec.handle("POST /repos/owner/test-repo/releases/42/assets", func(w http.ResponseWriter, _ *http.Request) {
+		uploads++
+		if uploads == 1 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message": "Validation Failed", "errors": [{"resource": "ReleaseAsset", "code": "already_exists", "field": "name"}]}`)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, "{}")
+	})
+	rec.handle("GET /repos/owner/test-repo/releases/42/assets", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[{"id": 7, "name": "checksums.txt"}]`)
+	})
+	deleted := false
+	rec.handle("DELETE /repos/owner/test-repo/releases/assets/7", func(w http.ResponseWriter, _ *http.Request) {
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	retried := mapValue(retries, "upload_asset")
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog"})
+	require.NoError(t, err)
+	require.Equal(t, retried+1, mapValue(retries, "upload_asset"))
+	require.Nil(t, rec.lastRelease())
+	require.NotNil(t, updated)
+	require.Equal(t, "changelog", updated.GetBody())
+	require.False(t, updated.GetDraft())
+	require.True(t, deleted)
+	require.Equal(t, 2, uploads)
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// closingReferenceRe matches the keywords that link a pull request or commit to the issues it closes.
+var closingReferenceRe = regexp.MustCompile(`(?i)\b(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+#(\d+)\b`)
+
+// releaseNameData is passed to the templates of the release name, released labels and milestone titles.
+type releaseNameData struct {
+	Version string
+	Tag     string
+	Branch  string
+	// Channel is the first prerelease identifier of the version, e.g. beta for 1.2.0-beta.1
+	Channel  string
+	Codename string
+}
+
+func newReleaseNameData(version, tag, branch string) releaseNameData {
+	channel := ""
+	if prerelease := semver.MustParse(version).Prerelease(); prerelease != "" {
+		channel = strings.SplitN(prerelease, ".", 2)[0]
+	}
+	return releaseNameData{Version: version, Tag: tag, Branch: branch, Channel: channel, Codename: codename(version)}
+}
+
+func parseLabelTemplates(option, value string) ([]*template.Template, error) {
+	templates := make([]*template.Template, 0)
+	for _, label := range splitList(value) {
+		tmpl, err := template.New(option).Parse(label)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", option, err)
+		}
+		templates = append(templates, tmpl)
+	}
+	return templates, nil
+}
+
+func renderLabels(templates []*template.Template, data releaseNameData) ([]string, error) {
+	labels := make([]string, 0, len(templates))
+	for _, tmpl := range templates {
+		var label strings.Builder
+		if err := tmpl.Execute(&label, data); err != nil {
+			return nil, err
+		}
+		// e.g. {{with .Channel}}released-on-@{{.}}{{end}} of a stable release
+		if name := strings.TrimSpace(label.String()); name != "" {
+			labels = append(labels, name)
+		}
+	}
+	return labels, nil
+}
+
+// releasedItems returns the numbers of the merged pull requests between the previous release and sha and the
+// numbers of the issues they (or their commits) close.
+func (repo *GitHubRepository) releasedItems(version, sha string) ([]int, []int, error) {
+	previousTag, err := repo.findPreviousTag(version)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find previous tag: %w", err)
+	}
+	commits, err := repo.listCommitsBetween(previousTag, sha)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list released commits: %w", err)
+	}
+	pullRequests, err := repo.listMergedPullRequests(commits)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list released pull requests: %w", err)
+	}
+	prNumbers := make([]int, 0, len(pullRequests))
+	isPullRequest := make(map[int]bool)
+	texts := make([]string, 0, len(commits)+len(pullRequests))
+	for _, pr := range pullRequests {
+		prNumbers = append(prNumbers, pr.GetNumber())
+		isPullRequest[pr.GetNumber()] = true
+		texts = append(texts, pr.GetBody())
+	}
+	for _, commit := range commits {
+		texts = append(texts, commit.Commit.GetMessage())
+	}
+	seen := make(map[int]bool)
+	issueNumbers := make([]int, 0)
+	for _, text := range texts {
+		for _, match := range closingReferenceRe.FindAllStringSubmatch(text, -1) {
+			number, _ := strconv.Atoi(match[1])
+			if seen[number] || isPullRequest[number] {
+				continue
+			}
+			seen[number] = true
+			issueNumbers = append(issueNumbers, number)
+		}
+	}
+	sort.Ints(issueNumbers)
+	return prNumbers, issueNumbers, nil
+}
+
+// labelReleasedItems adds and removes the configured labels on the pull requests and issues included in the release.
+func (repo *GitHubRepository) labelReleasedItems(version, tag, branch, sha string) error {
+	data := newReleaseNameData(version, tag, branch)
+	addLabels, err := renderLabels(repo.releasedLabels, data)
+	if err != nil {
+		return fmt.Errorf("failed to render github_released_labels: %w", err)
+	}
+	removeLabels, err := renderLabels(repo.releasedLabelsRemove, data)
+	if err != nil {
+		return fmt.Errorf("failed to render github_released_labels_remove: %w", err)
+	}
+	prNumbers, issueNumbers, err := repo.releasedItems(version, sha)
+	if err != nil {
+		return err
+	}
+	for _, number := range append(prNumbers, issueNumbers...) {
+		if len(addLabels) > 0 {
+			_, _, err := repo.client.Issues.AddLabelsToIssue(context.Background(), repo.owner, repo.repo, number, addLabels)
+			if err != nil {
+				return fmt.Errorf("failed to label #%d: %w", number, err)
+			}
+		}
+		for _, label := range removeLabels {
+			resp, err := repo.client.Issues.RemoveLabelForIssue(context.Background(), repo.owner, repo.repo, number, label)
+			// the label was not set
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to remove label %s from #%d: %w", label, number, err)
+			}
+		}
+	}
+	return nil
+}
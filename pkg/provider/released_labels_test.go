@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderLabels(t *testing.T) {
+	templates, err := parseLabelTemplates("github_released_labels", "released, {{with .Channel}}released-on-@{{.}}{{end}}")
+	require.NoError(t, err)
+	labels, err := renderLabels(templates, releaseNameData{Version: "1.0.0"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"released"}, labels)
+	labels, err = renderLabels(templates, releaseNameData{Version: "1.0.0-beta.1", Channel: "beta"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"released", "released-on-@beta"}, labels)
+
+	_, err = parseLabelTemplates("github_released_labels", "{{.Channel")
+	require.ErrorContains(t, err, "failed to parse github_released_labels")
+}
+
+func TestGithubReleasedLabels(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_released_labels":        "released,{{with .Channel}}released-on-@{{.}}{{end}}",
+		"github_released_labels_remove": "pending-release",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/compare/v1.1.1...deadbeef", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.CommitsComparison{Commits: []*github.RepositoryCommit{
+			createGithubCommit("abcd", "feat: new feature"),
+			createGithubCommit("1111", "fix: crash\n\nFixes #12"),
+		}})
+	})
+	rec.handle("GET /repos/owner/test-repo/commits/abcd/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		pr := createGithubPullRequest(3, "feat", "alice")
+		pr.Body = github.String("Closes #10, resolves: #11 and fixes #3")
+		_ = json.NewEncoder(w).Encode([]*github.PullRequest{pr})
+	})
+	rec.handle("GET /repos/owner/test-repo/commits/1111/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "[]")
+	})
+	added := make(map[int][]string)
+	removed := make([]int, 0)
+	for _, number := range []int{3, 10, 11, 12} {
+		number := number
+		rec.handle(fmt.Sprintf("POST /repos/owner/test-repo/issues/%d/labels", number), func(w http.ResponseWriter, r *http.Request) {
+			var labels []string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&labels))
+			added[number] = labels
+			fmt.Fprint(w, "[]")
+		})
+		rec.handle(fmt.Sprintf("DELETE /repos/owner/test-repo/issues/%d/labels/pending-release", number), func(w http.ResponseWriter, _ *http.Request) {
+			if number == 10 {
+				http.Error(w, `{"message":"Label does not exist"}`, http.StatusNotFound)
+				return
+			}
+			removed = append(removed, number)
+			fmt.Fprint(w, "[]")
+		})
+	}
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, map[int][]string{
+		3:  {"released"},
+		10: {"released"},
+		11: {"released"},
+		12: {"released"},
+	}, added)
+	require.Equal(t, []int{3, 11, 12}, removed)
+}
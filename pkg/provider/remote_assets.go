@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var assetDownloadClient = &http.Client{Timeout: 30 * time.Minute, CheckRedirect: assetRedirectPolicy}
+
+type assetHeadersKey struct{}
+
+// assetHeader is an HTTP header that is sent when downloading remote assets, only to host if it is set.
+type assetHeader struct {
+	host  string
+	name  string
+	value string
+}
+
+func isRemoteAsset(source string) bool {
+	return strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "http://")
+}
+
+// parseAssetHeaders parses one header per line, "Name: value" is sent to all hosts, "host Name: value" only to the
+// given host, so that credentials do not leak to other hosts.
+func parseAssetHeaders(value string) ([]*assetHeader, error) {
+	headers := make([]*assetHeader, 0)
+	for _, line := range strings.Split(value, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		header := &assetHeader{}
+		if first, rest, ok := strings.Cut(line, " "); ok && !strings.HasSuffix(first, ":") {
+			header.host, line = first, strings.TrimSpace(rest)
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok || strings.TrimSpace(name) == "" || strings.ContainsAny(strings.TrimSpace(name), " \t") {
+			return nil, fmt.Errorf("invalid github_assets_headers entry: %s", line)
+		}
+		header.name, header.value = strings.TrimSpace(name), strings.TrimSpace(value)
+		headers = append(headers, header)
+	}
+	return headers, nil
+}
+
+// matches reports whether the header is sent to the host of u.
+func (h *assetHeader) matches(u *url.URL) bool {
+	return h.host == "" || strings.EqualFold(h.host, u.Host) || strings.EqualFold(h.host, u.Hostname())
+}
+
+// applyAssetHeaders sets the headers matching the host of the request and removes the host scoped ones that do not,
+// e.g. after they have been copied to a redirect.
+func applyAssetHeaders(req *http.Request, headers []*assetHeader) {
+	for _, header := range headers {
+		if !header.matches(req.URL) {
+			req.Header.Del(header.name)
+		}
+	}
+	for _, header := range headers {
+		if header.matches(req.URL) {
+			req.Header.Set(header.name, header.value)
+		}
+	}
+}
+
+// assetRedirectPolicy applies the headers of the original request to the redirect target, the default policy of
+// net/http only removes Authorization and Cookie headers on redirects to other hosts.
+func assetRedirectPolicy(req *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return errors.New("stopped after 10 redirects")
+	}
+	if headers, ok := req.Context().Value(assetHeadersKey{}).([]*assetHeader); ok {
+		applyAssetHeaders(req, headers)
+	}
+	return nil
+}
+
+// redactAssetURL removes credentials and query parameters (e.g. signatures of pre-signed URLs) from error messages.
+func redactAssetURL(u *url.URL) string {
+	return fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, u.Path)
+}
+
+// downloadAsset downloads the remote asset into a temporary directory, the asset is named after the last segment of
+// the URL path.
+func (repo *GitHubRepository) downloadAsset(source string) (*releaseAsset, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid asset URL: %w", err)
+	}
+	name := path.Base(u.Path)
+	if name == "/" || name == "." {
+		return nil, fmt.Errorf("asset URL %s has no file name", redactAssetURL(u))
+	}
+	ctx := context.WithValue(context.Background(), assetHeadersKey{}, repo.assetHeaders)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid asset URL: %w", err)
+	}
+	applyAssetHeaders(req, repo.assetHeaders)
+	resp, err := assetDownloadClient.Do(req)
+	if err != nil {
+		// the error of the client quotes the full URL
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("failed to download asset %s: %w", redactAssetURL(u), err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download asset %s: %s", redactAssetURL(u), resp.Status)
+	}
+	dir, err := os.MkdirTemp("", "semrel-asset-")
+	if err != nil {
+		return nil, err
+	}
+	file := filepath.Join(dir, name)
+	f, err := os.Create(file)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	_, err = io.Copy(f, resp.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("failed to download asset %s: %w", redactAssetURL(u), err)
+	}
+	return &releaseAsset{name: name, path: file, tempDir: dir}, nil
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAssetHeaders(t *testing.T) {
+	headers, err := parseAssetHeaders("Authorization: Bearer token\n\nci.example.com X-Api-Key: key: with colon\n")
+	require.NoError(t, err)
+	require.Equal(t, []*assetHeader{
+		{name: "Authorization", value: "Bearer token"},
+		{host: "ci.example.com", name: "X-Api-Key", value: "key: with colon"},
+	}, headers)
+
+	_, err = parseAssetHeaders("Authorization Bearer token")
+	require.EqualError(t, err, "invalid github_assets_headers entry: Bearer token")
+}
+
+func TestGithubRemoteAssets(t *testing.T) {
+	artifacts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer ci-token" || r.Header.Get("X-Other") != "" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/artifacts/app.zip" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("zip"))
+	}))
+	defer artifacts.Close()
+	host := strings.TrimPrefix(artifacts.URL, "http://")
+
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_assets":         artifacts.URL + "/artifacts/app.zip?signature=secret",
+		"github_assets_headers": host + " Authorization: Bearer ci-token\nother.example.com X-Other: value",
+	})
+	defer ts.Close()
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	data, ok := rec.get("app.zip")
+	require.True(t, ok)
+	require.Equal(t, []byte("zip"), data)
+
+	// host scoped headers are not forwarded to the target of a redirect
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Private-Token") != "" || r.Header.Get("Authorization") != "" {
+			http.Error(w, "leaked credentials", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte("mirrored"))
+	}))
+	defer mirror.Close()
+	redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Private-Token") != "ci-token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		http.Redirect(w, r, mirror.URL+"/app.tar.gz", http.StatusFound)
+	}))
+	defer redirect.Close()
+	headers := repo.assetHeaders
+	repo.assetHeaders, err = parseAssetHeaders(strings.TrimPrefix(redirect.URL, "http://") + " PRIVATE-TOKEN: ci-token")
+	require.NoError(t, err)
+	repo.assetFiles = []string{redirect.URL + "/app.tar.gz"}
+	assets, err := repo.collectAssets("2.0.0", "v2.0.0")
+	require.NoError(t, err)
+	defer removeTemporaryAssets(assets)
+	data, err = os.ReadFile(assets[0].path)
+	require.NoError(t, err)
+	require.Equal(t, []byte("mirrored"), data)
+	repo.assetHeaders = headers
+
+	repo.assetFiles = []string{artifacts.URL + "/artifacts/missing.zip?signature=secret"}
+	_, err = repo.collectAssets("2.0.0", "v2.0.0")
+	require.EqualError(t, err, "failed to download asset "+artifacts.URL+"/artifacts/missing.zip: 404 Not Found")
+
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	repo.assetFiles = []string{closed.URL + "/app.zip?X-Amz-Signature=secret"}
+	_, err = repo.collectAssets("2.0.0", "v2.0.0")
+	require.ErrorContains(t, err, "failed to download asset "+closed.URL+"/app.zip: ")
+	require.NotContains(t, err.Error(), "secret")
+}
+
+func TestRemoveTemporaryAssets(t *testing.T) {
+	dir := t.TempDir()
+	tempDir, err := os.MkdirTemp(dir, "asset")
+	require.NoError(t, err)
+	removeTemporaryAssets([]*releaseAsset{{name: "app", path: dir + "/app"}, {name: "app.zip", path: tempDir + "/app.zip", tempDir: tempDir}})
+	require.DirExists(t, dir)
+	require.NoDirExists(t, tempDir)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/semrel"
+	"github.com/google/go-github/v66/github"
+)
+
+// repositoryAnnotations returns the metadata of the repository that is not part of provider.RepositoryInfo.
+func repositoryAnnotations(r *github.Repository) map[string]string {
+	visibility := r.GetVisibility()
+	if visibility == "" {
+		// older GitHub Enterprise Server versions do not return the visibility
+		visibility = "public"
+		if r.GetPrivate() {
+			visibility = "private"
+		}
+	}
+	return map[string]string{
+		"repo_topics":     strings.Join(r.Topics, ","),
+		"repo_license":    r.GetLicense().GetSPDXID(),
+		"repo_fork":       strconv.FormatBool(r.GetFork()),
+		"repo_parent":     r.GetParent().GetFullName(),
+		"repo_visibility": visibility,
+	}
+}
+
+// InvalidateInfo clears the repository information cached by GetInfo, so that the next call fetches it again.
+func (repo *GitHubRepository) InvalidateInfo() {
+	repo.info = nil
+	repo.repoAnnotations = nil
+}
+
+// RepositoryAnnotations returns the repository metadata (topics, license, fork, visibility) fetched by the last
+// GetInfo call. The same annotations are added to all commits returned by GetCommits.
+func (repo *GitHubRepository) RepositoryAnnotations() map[string]string {
+	annotations := make(map[string]string, len(repo.repoAnnotations))
+	for k, v := range repo.repoAnnotations {
+		annotations[k] = v
+	}
+	return annotations
+}
+
+func (repo *GitHubRepository) annotateRepository(commit *semrel.RawCommit) {
+	for k, v := range repo.repoAnnotations {
+		commit.Annotations[k] = v
+	}
+}
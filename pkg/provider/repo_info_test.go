@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositoryAnnotations(t *testing.T) {
+	annotations := repositoryAnnotations(&githubRepo)
+	require.Equal(t, map[string]string{
+		"repo_topics":     "",
+		"repo_license":    "",
+		"repo_fork":       "false",
+		"repo_parent":     "",
+		"repo_visibility": "private",
+	}, annotations)
+}
+
+func TestGithubRepositoryAnnotations(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo", func(w http.ResponseWriter, _ *http.Request) {
+		fork := githubRepo
+		fork.Fork = github.Bool(true)
+		fork.Visibility = github.String("internal")
+		fork.Topics = []string{"go", "semantic-release"}
+		fork.License = &github.License{SPDXID: github.String("MIT")}
+		fork.Parent = &github.Repository{FullName: github.String("upstream/test-repo")}
+		_ = json.NewEncoder(w).Encode(fork)
+	})
+	_, err := repo.GetInfo()
+	require.NoError(t, err)
+	expected := map[string]string{
+		"repo_topics":     "go,semantic-release",
+		"repo_license":    "MIT",
+		"repo_fork":       "true",
+		"repo_parent":     "upstream/test-repo",
+		"repo_visibility": "internal",
+	}
+	require.Equal(t, expected, repo.RepositoryAnnotations())
+
+	commits, err := repo.GetCommits("", "master")
+	require.NoError(t, err)
+	require.NotEmpty(t, commits)
+	for k, v := range expected {
+		require.Equal(t, v, commits[0].Annotations[k])
+	}
+}
+
+func TestGithubGetInfoCache(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+	requests := 0
+	rec.handle("GET /repos/owner/test-repo", func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		_ = json.NewEncoder(w).Encode(githubRepo)
+	})
+	for i := 0; i < 3; i++ {
+		repoInfo, err := repo.GetInfo()
+		require.NoError(t, err)
+		require.Equal(t, githubDefaultBranch, repoInfo.DefaultBranch)
+	}
+	require.Equal(t, 1, requests)
+
+	repo.InvalidateInfo()
+	require.Empty(t, repo.RepositoryAnnotations())
+	_, err := repo.GetInfo()
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// RollbackRelease deletes the GitHub release of version together with its tag. If unpublishOnly is set, the
+// release is converted back to a draft and the tag is kept.
+func (repo *GitHubRepository) RollbackRelease(version string, unpublishOnly bool) (err error) {
+	defer func() { err = classifyError(err) }()
+	tag := repo.formatTag(version)
+	release, err := repo.findExistingRelease(tag)
+	if err != nil {
+		return fmt.Errorf("failed to find release %s: %w", tag, err)
+	}
+	if unpublishOnly {
+		if release == nil {
+			return fmt.Errorf("release %s does not exist", tag)
+		}
+		_, _, err := repo.client.Repositories.EditRelease(context.Background(), repo.releaseOwner, repo.releaseRepo, release.GetID(), &github.RepositoryRelease{
+			Draft: github.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to unpublish release %s: %w", tag, err)
+		}
+		return nil
+	}
+	if release != nil {
+		if _, err := repo.client.Repositories.DeleteRelease(context.Background(), repo.releaseOwner, repo.releaseRepo, release.GetID()); err != nil {
+			return fmt.Errorf("failed to delete release %s: %w", tag, err)
+		}
+	}
+	resp, err := repo.client.Git.DeleteRef(context.Background(), repo.tagOwner, repo.tagRepo, "tags/"+tag)
+	if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusUnprocessableEntity) {
+		if release == nil {
+			return fmt.Errorf("neither release nor tag %s exist", tag)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete tag %s: %w", tag, err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubRollbackRelease(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/releases/tags/v2.0.0", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"id": 42, "tag_name": "v2.0.0"}`)
+	})
+	releaseDeleted := false
+	rec.handle("DELETE /repos/owner/test-repo/releases/42", func(w http.ResponseWriter, _ *http.Request) {
+		releaseDeleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	tagDeleted := false
+	rec.handle("DELETE /repos/owner/test-repo/git/refs/tags/v2.0.0", func(w http.ResponseWriter, _ *http.Request) {
+		tagDeleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	var unpublished *github.RepositoryRelease
+	rec.handle("PATCH /repos/owner/test-repo/releases/42", func(w http.ResponseWriter, r *http.Request) {
+		unpublished = &github.RepositoryRelease{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(unpublished))
+		fmt.Fprint(w, `{"id": 42}`)
+	})
+
+	require.NoError(t, repo.RollbackRelease("2.0.0", true))
+	require.True(t, unpublished.GetDraft())
+	require.False(t, releaseDeleted)
+	require.False(t, tagDeleted)
+
+	require.NoError(t, repo.RollbackRelease("2.0.0", false))
+	require.True(t, releaseDeleted)
+	require.True(t, tagDeleted)
+}
+
+func TestGithubRollbackMissingRelease(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+	defer ts.Close()
+	rec.handle("DELETE /repos/owner/test-repo/git/refs/tags/v9.0.0", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "Reference does not exist", http.StatusUnprocessableEntity)
+	})
+
+	require.ErrorContains(t, repo.RollbackRelease("9.0.0", true), "release v9.0.0 does not exist")
+	require.ErrorContains(t, repo.RollbackRelease("9.0.0", false), "neither release nor tag v9.0.0 exist")
+}
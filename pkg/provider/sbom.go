@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+const (
+	sbomFormatSPDX      = "spdx"
+	sbomFormatCycloneDX = "cyclonedx"
+)
+
+type sbomNameData struct {
+	Repo    string
+	Version string
+	Tag     string
+	Format  string
+	Name    string
+	Ext     string
+}
+
+// detectSBOMFormat validates that the given data is a SPDX (JSON or tag-value) or CycloneDX (JSON or XML) document.
+func detectSBOMFormat(data []byte) (string, error) {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.HasPrefix(trimmed, []byte("{")) {
+		var doc struct {
+			SPDXVersion string `json:"spdxVersion"`
+			BOMFormat   string `json:"bomFormat"`
+		}
+		if err := json.Unmarshal(trimmed, &doc); err != nil {
+			return "", fmt.Errorf("invalid JSON: %w", err)
+		}
+		switch {
+		case strings.HasPrefix(doc.SPDXVersion, "SPDX-"):
+			return sbomFormatSPDX, nil
+		case doc.BOMFormat == "CycloneDX":
+			return sbomFormatCycloneDX, nil
+		}
+		return "", errors.New("JSON document is neither SPDX nor CycloneDX")
+	}
+	if bytes.HasPrefix(trimmed, []byte("SPDXVersion:")) {
+		return sbomFormatSPDX, nil
+	}
+	if bytes.HasPrefix(trimmed, []byte("<")) {
+		var doc struct {
+			XMLName xml.Name
+		}
+		if err := xml.Unmarshal(trimmed, &doc); err != nil {
+			return "", fmt.Errorf("invalid XML: %w", err)
+		}
+		if doc.XMLName.Local == "bom" && strings.HasPrefix(doc.XMLName.Space, "http://cyclonedx.org/schema/bom/") {
+			return sbomFormatCycloneDX, nil
+		}
+		return "", errors.New("XML document is not CycloneDX")
+	}
+	return "", errors.New("unknown SBOM format")
+}
+
+// collectSBOMs validates the SBOM files and renders their asset names before the release is created, so that an
+// invalid SBOM or a name that is already taken by another asset does not leave a partially published release.
+func (repo *GitHubRepository) collectSBOMs(version, tag string, otherAssets []*releaseAsset) ([]*releaseAsset, error) {
+	files, err := globFiles(repo.sbomFiles)
+	if err != nil {
+		return nil, err
+	}
+	paths := make(map[string]string, len(otherAssets)+len(files))
+	for _, asset := range otherAssets {
+		paths[asset.name] = asset.path
+	}
+	assets := make([]*releaseAsset, 0, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SBOM %s: %w", file, err)
+		}
+		format, err := detectSBOMFormat(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SBOM %s: %w", file, err)
+		}
+		name := filepath.Base(file)
+		if repo.sbomNameTemplate != nil {
+			ext := filepath.Ext(name)
+			buf := &bytes.Buffer{}
+			err := repo.sbomNameTemplate.Execute(buf, sbomNameData{
+				Repo:    repo.repo,
+				Version: version,
+				Tag:     tag,
+				Format:  format,
+				Name:    strings.TrimSuffix(name, ext),
+				Ext:     ext,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to render SBOM name: %w", err)
+			}
+			name = buf.String()
+		}
+		if other, ok := paths[name]; ok {
+			return nil, fmt.Errorf("duplicate asset name %s of SBOM %s (%s)", name, file, other)
+		}
+		paths[name] = file
+		assets = append(assets, &releaseAsset{name: name, path: file})
+	}
+	return assets, nil
+}
+
+// globFiles expands the given glob patterns, a pattern without a match is treated as an error.
+func globFiles(patterns []string) ([]string, error) {
+	files := make([]string, 0)
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no files found for %s", pattern)
+		}
+		files = append(files, matches...)
+	}
+	return files, nil
+}
+
+func parseSBOMNameTemplate(rawTemplate string) (*template.Template, error) {
+	if rawTemplate == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New("sbom").Parse(rawTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse github_sbom_name_template: %w", err)
+	}
+	return tmpl, nil
+}
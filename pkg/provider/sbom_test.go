@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectSBOMFormat(t *testing.T) {
+	testCases := []struct {
+		name   string
+		data   string
+		format string
+	}{
+		{"spdx-json", `{"spdxVersion": "SPDX-2.3", "name": "test"}`, sbomFormatSPDX},
+		{"spdx-tag-value", "SPDXVersion: SPDX-2.3\nDataLicense: CC0-1.0\n", sbomFormatSPDX},
+		{"cyclonedx-json", `{"bomFormat": "CycloneDX", "specVersion": "1.5"}`, sbomFormatCycloneDX},
+		{"cyclonedx-xml", `<?xml version="1.0"?><bom xmlns="http://cyclonedx.org/schema/bom/1.5" version="1"></bom>`, sbomFormatCycloneDX},
+		{"unknown-json", `{"name": "test"}`, ""},
+		{"unknown-xml", `<project></project>`, ""},
+		{"text", "hello world", ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			format, err := detectSBOMFormat([]byte(tc.data))
+			if tc.format == "" {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.format, format)
+		})
+	}
+}
+
+func TestGithubUploadSBOMs(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sbom.json"), []byte(`{"bomFormat": "CycloneDX"}`), 0o600))
+
+	repo, ts, uploads := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_sbom_files":         filepath.Join(dir, "*.json"),
+		"github_sbom_name_template": "{{.Repo}}-{{.Version}}.{{.Format}}{{.Ext}}",
+	})
+	defer ts.Close()
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	_, ok := uploads.get("test-repo-2.0.0.cyclonedx.json")
+	require.True(t, ok)
+}
+
+func TestGithubUploadInvalidSBOM(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sbom.json"), []byte(`{}`), 0o600))
+
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_sbom_files": filepath.Join(dir, "sbom.json"),
+	})
+	defer ts.Close()
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.ErrorContains(t, err, "invalid SBOM")
+	// the SBOMs are validated before the release is created
+	require.Nil(t, rec.lastRelease())
+}
+
+func TestGithubUploadDuplicateSBOMNames(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.json"), []byte(`{"bomFormat": "CycloneDX"}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "lib.json"), []byte(`{"bomFormat": "CycloneDX"}`), 0o600))
+
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_sbom_files":         filepath.Join(dir, "*.json"),
+		"github_sbom_name_template": "{{.Repo}}.{{.Format}}{{.Ext}}",
+	})
+	defer ts.Close()
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.ErrorContains(t, err, "duplicate asset name test-repo.cyclonedx.json of SBOM "+filepath.Join(dir, "lib.json"))
+	require.Nil(t, rec.lastRelease())
+}
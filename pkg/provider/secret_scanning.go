@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// listSecretScanningAlerts returns the open secret scanning alerts of the repository.
+func (repo *GitHubRepository) listSecretScanningAlerts() ([]*github.SecretScanningAlert, error) {
+	allAlerts := make([]*github.SecretScanningAlert, 0)
+	opts := &github.SecretScanningAlertListOptions{State: "open", ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		alerts, resp, err := repo.client.SecretScanning.ListAlertsForRepo(context.Background(), repo.owner, repo.repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		allAlerts = append(allAlerts, alerts...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.ListOptions.Page = resp.NextPage
+	}
+	return allAlerts, nil
+}
+
+// checkSecretScanningAlerts applies the configured action if the repository has open secret scanning alerts, so
+// that code with known leaked credentials is not released. The secrets themselves are never logged.
+func (repo *GitHubRepository) checkSecretScanningAlerts(prerelease, draft *bool) error {
+	alerts, err := repo.listSecretScanningAlerts()
+	if err != nil {
+		return fmt.Errorf("failed to list secret scanning alerts: %w", err)
+	}
+	if len(alerts) == 0 {
+		return nil
+	}
+	descriptions := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		descriptions = append(descriptions, fmt.Sprintf("#%d (%s)", alert.GetNumber(), alert.GetSecretTypeDisplayName()))
+	}
+	reason := fmt.Sprintf("%d open secret scanning alert(s): %s", len(alerts), strings.Join(descriptions, ", "))
+	return applyGate(repo.secretScanningAction, reason, prerelease, draft)
+}
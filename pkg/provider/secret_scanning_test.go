@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubSecretScanningGate(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_secret_scanning_gate": "true"})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "open", r.URL.Query().Get("state"))
+		fmt.Fprint(w, `[{"number":4,"secret_type_display_name":"GitHub Personal Access Token","secret":"ghp_secret"}]`)
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.EqualError(t, err, "1 open secret scanning alert(s): #4 (GitHub Personal Access Token), the release is blocked")
+	require.Nil(t, rec.lastRelease())
+}
+
+func TestGithubSecretScanningGateNoAlerts(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_secret_scanning_gate":        "true",
+		"github_secret_scanning_gate_action": "draft",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/secret-scanning/alerts", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.False(t, rec.lastRelease().GetDraft())
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const (
+	gateActionBlock      = "block"
+	gateActionPrerelease = "prerelease"
+	gateActionDraft      = "draft"
+)
+
+// alertSeverities are the severities of security alerts in ascending order.
+var alertSeverities = []string{"low", "medium", "high", "critical"}
+
+// parseSeverityThreshold validates the severity option and returns the severities at or above it.
+func parseSeverityThreshold(key, value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+	for i, severity := range alertSeverities {
+		if severity == value {
+			return alertSeverities[i:], nil
+		}
+	}
+	return nil, fmt.Errorf("invalid value for %s: %s", key, value)
+}
+
+func parseGateAction(key, value string) (string, error) {
+	switch value {
+	case "":
+		return gateActionBlock, nil
+	case gateActionBlock, gateActionPrerelease, gateActionDraft:
+		return value, nil
+	default:
+		return "", fmt.Errorf("invalid value for %s: %s", key, value)
+	}
+}
+
+// applyGate blocks the release or downgrades it to a prerelease or draft, depending on the action.
+func applyGate(action, reason string, prerelease, draft *bool) error {
+	switch action {
+	case gateActionPrerelease:
+		log.Printf("warning: %s, publishing the release as prerelease", reason)
+		*prerelease = true
+	case gateActionDraft:
+		log.Printf("warning: %s, publishing the release as draft", reason)
+		*draft = true
+	default:
+		return fmt.Errorf("%s, the release is blocked", reason)
+	}
+	return nil
+}
+
+// severitiesDescription returns e.g. "high or critical" for the severities of a threshold.
+func severitiesDescription(severities []string) string {
+	if len(severities) == 1 {
+		return severities[0]
+	}
+	return strings.Join(severities[:len(severities)-1], ", ") + " or " + severities[len(severities)-1]
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// checkSignedCommits fails if a commit of the release range does not have a verified signature, the error lists
+// the offending commits and the reason reported by GitHub (e.g. unsigned or unknown_key).
+func checkSignedCommits(commits []*github.RepositoryCommit) error {
+	unverified := make([]string, 0)
+	for _, commit := range commits {
+		verification := commit.GetCommit().GetVerification()
+		if verification.GetVerified() {
+			continue
+		}
+		reason := verification.GetReason()
+		if reason == "" {
+			reason = "unsigned"
+		}
+		unverified = append(unverified, fmt.Sprintf("%s (%s)", commit.GetSHA(), reason))
+	}
+	if len(unverified) > 0 {
+		return fmt.Errorf("%d commit(s) without a verified signature: %s", len(unverified), strings.Join(unverified, ", "))
+	}
+	return nil
+}
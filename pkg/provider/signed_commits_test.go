@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func createSignedGithubCommit(sha string, verified bool, reason string) *github.RepositoryCommit {
+	commit := createGithubCommit(sha, "feat: signed")
+	commit.Commit.Verification = &github.SignatureVerification{Verified: github.Bool(verified), Reason: github.String(reason)}
+	return commit
+}
+
+func TestGithubRequireSignedCommits(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_require_signed_commits": "true"})
+	defer ts.Close()
+	commits := []*github.RepositoryCommit{
+		createSignedGithubCommit("c3", true, "valid"),
+		createSignedGithubCommit("c2", false, "unknown_key"),
+		createGithubCommit("c1", "fix: unsigned"),
+	}
+	rec.handle("GET /repos/owner/test-repo/commits", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(commits)
+	})
+
+	_, err := repo.GetCommits("", "c3")
+	require.EqualError(t, err, "2 commit(s) without a verified signature: c2 (unknown_key), c1 (unsigned)")
+
+	commits = commits[:1]
+	listed, err := repo.GetCommits("", "c3")
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+}
+
+func TestGithubRequireSignedCommitsOfMergedBranches(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_require_signed_commits": "true",
+		"github_first_parent":           "true",
+		"github_max_commits":            "1",
+	})
+	defer ts.Close()
+	commits := make([]*github.RepositoryCommit, 0, len(firstParentCommits))
+	for _, commit := range firstParentCommits {
+		signed := createSignedGithubCommit(commit.GetSHA(), commit.GetSHA() != "f2", "")
+		signed.Parents = commit.Parents
+		commits = append(commits, signed)
+	}
+	rec.handle("GET /repos/owner/test-repo/commits", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(commits)
+	})
+
+	_, err := repo.GetCommits("base", "m2")
+	require.EqualError(t, err, "1 commit(s) without a verified signature: f2 (unsigned)")
+}
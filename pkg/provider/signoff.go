@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+var signedOffByRe = regexp.MustCompile(`(?mi)^Signed-off-by: .+ <[^>]+>\s*$`)
+
+// checkSignoff fails if a commit of the release range does not carry a Signed-off-by trailer (DCO). Merge commits
+// are skipped, because they are usually created by GitHub without a trailer.
+func checkSignoff(commits []*github.RepositoryCommit) error {
+	missing := make([]string, 0)
+	for _, commit := range commits {
+		if len(commit.Parents) > 1 || signedOffByRe.MatchString(commit.GetCommit().GetMessage()) {
+			continue
+		}
+		missing = append(missing, commit.GetSHA())
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%d commit(s) without a Signed-off-by trailer: %s", len(missing), strings.Join(missing, ", "))
+	}
+	return nil
+}
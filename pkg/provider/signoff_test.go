@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubRequireSignoff(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_require_signoff": "true"})
+	defer ts.Close()
+	merge := createGithubCommit("c4", "Merge pull request #1")
+	merge.Parents = []*github.Commit{{SHA: github.String("c3")}, {SHA: github.String("c2")}}
+	commits := []*github.RepositoryCommit{
+		merge,
+		createGithubCommit("c3", "feat: dco\n\nSigned-off-by: Jane Doe <jane@example.com>"),
+		createGithubCommit("c2", "fix: no dco"),
+		createGithubCommit("c1", "fix: trailer in body\n\nthe Signed-off-by: line is missing"),
+	}
+	rec.handle("GET /repos/owner/test-repo/commits", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(commits)
+	})
+
+	_, err := repo.GetCommits("", "c4")
+	require.EqualError(t, err, "2 commit(s) without a Signed-off-by trailer: c2, c1")
+
+	commits = commits[:2]
+	listed, err := repo.GetCommits("", "c4")
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+}
+
+func TestGithubRequireSignoffOfMergedBranches(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_require_signoff": "true",
+		"github_first_parent":    "true",
+		"github_max_commits":     "1",
+	})
+	defer ts.Close()
+	commits := make([]*github.RepositoryCommit, 0, len(firstParentCommits))
+	for _, commit := range firstParentCommits {
+		message := commit.GetCommit().GetMessage()
+		if commit.GetSHA() != "f1" {
+			message += "\n\nSigned-off-by: Jane Doe <jane@example.com>"
+		}
+		signedOff := createGithubCommit(commit.GetSHA(), message)
+		signedOff.Parents = commit.Parents
+		commits = append(commits, signedOff)
+	}
+	rec.handle("GET /repos/owner/test-repo/commits", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(commits)
+	})
+
+	_, err := repo.GetCommits("base", "m2")
+	require.EqualError(t, err, "1 commit(s) without a Signed-off-by trailer: f1")
+}
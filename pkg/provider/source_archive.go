@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+const maxSubmoduleDepth = 5
+
+// parseGitmodules returns the submodule paths configured in a .gitmodules file.
+func parseGitmodules(content string) []string {
+	paths := make([]string, 0)
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if !found || strings.TrimSpace(key) != "path" {
+			continue
+		}
+		paths = append(paths, strings.TrimSpace(value))
+	}
+	return paths
+}
+
+// parseSubmoduleURL extracts the owner and repository name from a GitHub submodule URL, relative URLs (e.g. ../lib.git)
+// are resolved against the superproject owner/name like git does.
+func parseSubmoduleURL(rawURL, host, owner, name string) (string, string, error) {
+	repoPath := ""
+	switch {
+	case strings.HasPrefix(rawURL, "./") || strings.HasPrefix(rawURL, "../"):
+		repoPath = path.Join("/", owner, name, rawURL)
+	case strings.HasPrefix(rawURL, "git@"):
+		urlHost, p, _ := strings.Cut(strings.TrimPrefix(rawURL, "git@"), ":")
+		if urlHost != host {
+			return "", "", fmt.Errorf("submodule %s is not hosted on %s", rawURL, host)
+		}
+		repoPath = p
+	default:
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return "", "", err
+		}
+		if u.Host != host {
+			return "", "", fmt.Errorf("submodule %s is not hosted on %s", rawURL, host)
+		}
+		repoPath = u.Path
+	}
+	subOwner, subName, found := strings.Cut(strings.Trim(strings.TrimSuffix(repoPath, ".git"), "/"), "/")
+	if !found || subOwner == "" || subName == "" || strings.Contains(subName, "/") {
+		return "", "", fmt.Errorf("invalid submodule url: %s", rawURL)
+	}
+	return subOwner, subName, nil
+}
+
+func (repo *GitHubRepository) downloadTarball(owner, name, sha string) (io.ReadCloser, error) {
+	archiveURL, _, err := repo.client.Repositories.GetArchiveLink(context.Background(), owner, name, github.Tarball, &github.RepositoryContentGetOptions{Ref: sha}, 3)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tarball link for %s/%s: %w", owner, name, err)
+	}
+	resp, err := repo.client.Client().Get(archiveURL.String())
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to download tarball for %s/%s: %s", owner, name, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// copyTarball copies the entries of the GitHub generated tarball into tw, replacing the top level directory with prefix.
+func copyTarball(tw *tar.Writer, r io.Reader, prefix string) error {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gzr.Close()
+	tr := tar.NewReader(gzr)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag == tar.TypeXGlobalHeader {
+			continue
+		}
+		_, rest, _ := strings.Cut(hdr.Name, "/")
+		if rest == "" {
+			continue
+		}
+		hdr.Name = path.Join(prefix, rest)
+		if hdr.Typeflag == tar.TypeDir {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, tr); err != nil { //nolint:gosec
+			return err
+		}
+	}
+}
+
+func (repo *GitHubRepository) writeSourceTree(tw *tar.Writer, owner, name, sha, prefix string, depth int) error {
+	if depth > maxSubmoduleDepth {
+		return fmt.Errorf("submodules nested deeper than %d levels", maxSubmoduleDepth)
+	}
+	tarball, err := repo.downloadTarball(owner, name, sha)
+	if err != nil {
+		return err
+	}
+	err = copyTarball(tw, tarball, prefix)
+	tarball.Close()
+	if err != nil {
+		return fmt.Errorf("failed to copy tarball of %s/%s: %w", owner, name, err)
+	}
+
+	opts := &github.RepositoryContentGetOptions{Ref: sha}
+	gitmodules, _, resp, err := repo.client.Repositories.GetContents(context.Background(), owner, name, ".gitmodules", opts)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	content, err := gitmodules.GetContent()
+	if err != nil {
+		return err
+	}
+	for _, subPath := range parseGitmodules(content) {
+		submodule, _, _, err := repo.client.Repositories.GetContents(context.Background(), owner, name, subPath, opts)
+		if err != nil {
+			return fmt.Errorf("failed to resolve submodule %s: %w", subPath, err)
+		}
+		if submodule.GetType() != "submodule" {
+			continue
+		}
+		subOwner, subName, err := parseSubmoduleURL(submodule.GetSubmoduleGitURL(), repo.webHost(), owner, name)
+		if err != nil {
+			return err
+		}
+		err = repo.writeSourceTree(tw, subOwner, subName, submodule.GetSHA(), path.Join(prefix, subPath), depth+1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// webHost returns the host used in git clone URLs of this GitHub instance.
+func (repo *GitHubRepository) webHost() string {
+	if repo.client.BaseURL.Host == "api.github.com" {
+		return "github.com"
+	}
+	return repo.client.BaseURL.Host
+}
+
+// createSourceArchive writes a tar.gz of the repository at sha including all (GitHub hosted) submodules to a temporary file.
+func (repo *GitHubRepository) createSourceArchive(sha, prefix string) (*os.File, error) {
+	f, err := os.CreateTemp("", "semrel-source-*.tar.gz")
+	if err != nil {
+		return nil, err
+	}
+	gzw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gzw)
+	err = repo.writeSourceTree(tw, repo.owner, repo.repo, sha, prefix, 0)
+	if err == nil {
+		err = tw.Close()
+	}
+	if err == nil {
+		err = gzw.Close()
+	}
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	return f, nil
+}
+
+func (repo *GitHubRepository) uploadSourceArchive(sha, version string, releaseID int64) (*uploadedAsset, error) {
+	prefix := fmt.Sprintf("%s-%s", repo.repo, version)
+	f, err := repo.createSourceArchive(sha, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create source archive: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return repo.uploadReleaseAsset(releaseID, prefix+"-full.tar.gz", f, stat.Size())
+}
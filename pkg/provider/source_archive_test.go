@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestTarball(t *testing.T, topLevel string, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: topLevel + "/", Mode: 0o755}))
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: topLevel + "/" + name, Mode: 0o644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	return buf.Bytes()
+}
+
+func readTestTarball(t *testing.T, data []byte) map[string]string {
+	gzr, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(content)
+	}
+}
+
+func TestParseSubmoduleURL(t *testing.T) {
+	testCases := []struct {
+		url   string
+		owner string
+		repo  string
+	}{
+		{"https://github.com/owner/sub.git", "owner", "sub"},
+		{"https://github.com/owner/sub", "owner", "sub"},
+		{"git@github.com:owner/sub.git", "owner", "sub"},
+		{"https://gitlab.com/owner/sub.git", "", ""},
+		{"https://github.com/owner", "", ""},
+		{"../lib.git", "owner", "lib"},
+		{"../../other/lib", "other", "lib"},
+		{"./lib.git", "", ""},
+		{"../../../lib.git", "", ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			owner, repo, err := parseSubmoduleURL(tc.url, "github.com", "owner", "repo")
+			if tc.owner == "" {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.owner, owner)
+			require.Equal(t, tc.repo, repo)
+		})
+	}
+}
+
+func TestGithubUploadSourceArchive(t *testing.T) {
+	repo, ts, uploads := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_source_archive": "true",
+	})
+	defer ts.Close()
+
+	mainTarball := createTestTarball(t, "owner-test-repo-deadbeef", map[string]string{"README.md": "main"})
+	subTarball := createTestTarball(t, "owner-sub-cafe", map[string]string{"sub.go": "package sub"})
+	gitmodules := base64.StdEncoding.EncodeToString([]byte("[submodule \"sub\"]\n\tpath = lib/sub\n\turl = https://github.com/owner/sub.git\n"))
+
+	archiveServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/test-repo/tarball/deadbeef":
+			http.Redirect(w, r, "http://"+r.Host+"/download/main.tar.gz", http.StatusFound)
+		case "/repos/owner/sub/tarball/cafe":
+			http.Redirect(w, r, "http://"+r.Host+"/download/sub.tar.gz", http.StatusFound)
+		case "/download/main.tar.gz":
+			_, _ = w.Write(mainTarball)
+		case "/download/sub.tar.gz":
+			_, _ = w.Write(subTarball)
+		case "/repos/owner/test-repo/contents/.gitmodules":
+			_ = json.NewEncoder(w).Encode(map[string]string{"type": "file", "encoding": "base64", "content": gitmodules})
+		case "/repos/owner/test-repo/contents/lib/sub":
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"type":              "submodule",
+				"sha":               "cafe",
+				"submodule_git_url": "https://" + r.Host + "/owner/sub.git",
+			})
+		case "/repos/owner/sub/contents/.gitmodules":
+			http.Error(w, "not found", http.StatusNotFound)
+		default:
+			ts.Config.Handler.ServeHTTP(w, r)
+		}
+	}))
+	defer archiveServer.Close()
+	repo.client.BaseURL, _ = url.Parse(archiveServer.URL + "/")
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+
+	data, ok := uploads.get("test-repo-2.0.0-full.tar.gz")
+	require.True(t, ok)
+	files := readTestTarball(t, data)
+	require.Equal(t, "main", files["test-repo-2.0.0/README.md"])
+	require.Equal(t, "package sub", files["test-repo-2.0.0/lib/sub/sub.go"])
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-github/v66/github"
+)
+
+func (repo *GitHubRepository) supersededBanner(tag string) string {
+	return fmt.Sprintf("> [!NOTE]\n> This release has been superseded by [%s](%s/releases/tag/%s).", tag, repo.releaseRepoURL(), tag)
+}
+
+// markPreviousReleaseSuperseded appends a banner linking the new release to the body of the previous stable release.
+func (repo *GitHubRepository) markPreviousReleaseSuperseded(version, tag string) error {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return err
+	}
+	if v.Prerelease() != "" {
+		return nil
+	}
+	previousTag, err := repo.findPreviousTag(version)
+	if err != nil {
+		return err
+	}
+	if previousTag == "" {
+		return nil
+	}
+	previous, resp, err := repo.client.Repositories.GetReleaseByTag(context.Background(), repo.releaseOwner, repo.releaseRepo, previousTag)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get previous release %s: %w", previousTag, err)
+	}
+	banner := repo.supersededBanner(tag)
+	if strings.Contains(previous.GetBody(), banner) {
+		return nil
+	}
+	_, _, err = repo.client.Repositories.EditRelease(context.Background(), repo.releaseOwner, repo.releaseRepo, previous.GetID(), &github.RepositoryRelease{
+		Body: github.String(appendSection(previous.GetBody(), banner)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark previous release %s as superseded: %w", previousTag, err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubMarkSuperseded(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_mark_superseded": "true",
+	})
+	defer ts.Close()
+	previousBody := "old changelog"
+	rec.handle("GET /repos/owner/test-repo/releases/tags/v1.1.1", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.RepositoryRelease{ID: github.Int64(11), TagName: github.String("v1.1.1"), Body: &previousBody})
+	})
+	edits := 0
+	rec.handle("PATCH /repos/owner/test-repo/releases/11", func(w http.ResponseWriter, r *http.Request) {
+		data := &github.RepositoryRelease{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(data))
+		previousBody = data.GetBody()
+		edits++
+		fmt.Fprint(w, "{}")
+	})
+
+	for i := 0; i < 2; i++ {
+		err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+		require.NoError(t, err)
+	}
+	expected := "old changelog\n\n> [!NOTE]\n> This release has been superseded by [v2.0.0](" + repo.repoURL() + "/releases/tag/v2.0.0)."
+	require.Equal(t, expected, previousBody)
+	require.Equal(t, 1, edits)
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const tagFormatVersionPlaceholder = "\x00"
+
+const (
+	tagVersionParsingStrict = "strict"
+	tagVersionParsingCoerce = "coerce"
+)
+
+// tagFormat describes how versions are mapped to tag names, e.g. the tag_format myapp-v{{.Version}}
+// results in the prefix myapp-v and an empty suffix.
+type tagFormat struct {
+	prefix string
+	suffix string
+}
+
+func parseTagFormat(rawFormat string) (*tagFormat, error) {
+	if rawFormat == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New("tag").Parse(rawFormat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tag_format: %w", err)
+	}
+	sb := &strings.Builder{}
+	if err := tmpl.Execute(sb, struct{ Version string }{tagFormatVersionPlaceholder}); err != nil {
+		return nil, fmt.Errorf("failed to render tag_format: %w", err)
+	}
+	prefix, suffix, found := strings.Cut(sb.String(), tagFormatVersionPlaceholder)
+	if !found || strings.Contains(suffix, tagFormatVersionPlaceholder) {
+		return nil, errors.New("tag_format must contain {{.Version}} exactly once")
+	}
+	return &tagFormat{prefix: prefix, suffix: suffix}, nil
+}
+
+// formatTag returns the tag name for the given version.
+func (repo *GitHubRepository) formatTag(version string) string {
+	if repo.tagFormat != nil {
+		return repo.tagPrefix + repo.tagFormat.prefix + version + repo.tagFormat.suffix
+	}
+	if repo.stripVTagPrefix {
+		return repo.tagPrefix + version
+	}
+	return repo.tagPrefix + "v" + version
+}
+
+// tagsRef returns the ref used to list the tags that may belong to a release.
+func (repo *GitHubRepository) tagsRef() string {
+	if repo.tagPrefix == "" {
+		return "tags"
+	}
+	return "tags/" + repo.tagPrefix
+}
+
+// isExcludedTag reports whether the tag matches the tag_exclude pattern.
+func (repo *GitHubRepository) isExcludedTag(tag string) bool {
+	return repo.tagExclude != nil && repo.tagExclude.MatchString(tag)
+}
+
+// parseTagVersion extracts the version from the given tag name.
+func (repo *GitHubRepository) parseTagVersion(tag string) (*semver.Version, error) {
+	if repo.tagPrefix != "" {
+		if !strings.HasPrefix(tag, repo.tagPrefix) {
+			return nil, fmt.Errorf("tag %s does not have the prefix %s", tag, repo.tagPrefix)
+		}
+		tag = strings.TrimPrefix(tag, repo.tagPrefix)
+	}
+	if repo.tagFormat != nil {
+		if !strings.HasPrefix(tag, repo.tagFormat.prefix) || !strings.HasSuffix(tag, repo.tagFormat.suffix) ||
+			len(tag) <= len(repo.tagFormat.prefix)+len(repo.tagFormat.suffix) {
+			return nil, fmt.Errorf("tag %s does not match tag_format", tag)
+		}
+		tag = strings.TrimSuffix(strings.TrimPrefix(tag, repo.tagFormat.prefix), repo.tagFormat.suffix)
+	}
+	if repo.strictTagVersions() {
+		return semver.StrictNewVersion(strings.TrimPrefix(tag, "v"))
+	}
+	return semver.NewVersion(tag)
+}
+
+// strictTagVersions reports whether tags must be complete semantic versions. Otherwise versions are coerced,
+// e.g. v1.2 is parsed as 1.2.0.
+func (repo *GitHubRepository) strictTagVersions() bool {
+	if repo.tagVersionParsing != "" {
+		return repo.tagVersionParsing == tagVersionParsingStrict
+	}
+	// floating alias tags like v1 or v1.2 must not be parsed as v1.0.0 or v1.2.0
+	return repo.updateMajorTags || repo.updateMinorTags
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/go-semantic-release/semantic-release/v2/pkg/semrel"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTagFormat(t *testing.T) {
+	format, err := parseTagFormat("myapp-v{{.Version}}")
+	require.NoError(t, err)
+	require.Equal(t, &tagFormat{prefix: "myapp-v"}, format)
+
+	format, err = parseTagFormat("release/{{.Version}}/final")
+	require.NoError(t, err)
+	require.Equal(t, &tagFormat{prefix: "release/", suffix: "/final"}, format)
+
+	_, err = parseTagFormat("myapp")
+	require.ErrorContains(t, err, "exactly once")
+	_, err = parseTagFormat("{{.Version}}-{{.Version}}")
+	require.ErrorContains(t, err, "exactly once")
+	_, err = parseTagFormat("{{.Version")
+	require.ErrorContains(t, err, "failed to parse tag_format")
+}
+
+func TestGithubTagFormat(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"tag_format": "myapp-v{{.Version}}",
+	})
+	defer ts.Close()
+
+	rec.handle("GET /repos/owner/test-repo/git/matching-refs/tags", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]*github.Reference{
+			createGithubRef("refs/tags/v3.0.0"),
+			createGithubRef("refs/tags/myapp-v1.0.0"),
+			createGithubRef("refs/tags/myapp-v1.2.0"),
+			createGithubRef("refs/tags/other-v2.0.0"),
+		})
+	})
+	releases, err := repo.GetReleases("")
+	require.NoError(t, err)
+	require.Len(t, releases, 2)
+	latest, err := semrel.GetLatestReleaseFromReleases(releases, "")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.0", latest.Version)
+
+	var createdRef map[string]string
+	rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&createdRef))
+		fmt.Fprint(w, "{}")
+	})
+	validTags["myapp-v1.3.0"] = true
+	defer delete(validTags, "myapp-v1.3.0")
+	err = repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "1.3.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, "refs/tags/myapp-v1.3.0", createdRef["ref"])
+	require.Equal(t, "myapp-v1.3.0", rec.lastRelease().GetTagName())
+}
+
+func TestGithubTagPrefix(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"tag_prefix": "api/",
+	})
+	defer ts.Close()
+
+	rec.handle("GET /repos/owner/test-repo/git/matching-refs/tags/api/", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]*github.Reference{
+			createGithubRef("refs/tags/api/v1.0.0"),
+			createGithubRef("refs/tags/api/v1.2.0"),
+			createGithubRef("refs/tags/api/legacy"),
+		})
+	})
+	releases, err := repo.GetReleases("")
+	require.NoError(t, err)
+	require.Len(t, releases, 2)
+	latest, err := semrel.GetLatestReleaseFromReleases(releases, "")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.0", latest.Version)
+
+	var createdRef map[string]string
+	rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&createdRef))
+		fmt.Fprint(w, "{}")
+	})
+	validTags["api/v1.3.0"] = true
+	defer delete(validTags, "api/v1.3.0")
+	err = repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "1.3.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, "refs/tags/api/v1.3.0", createdRef["ref"])
+}
+
+func TestGithubTagExclude(t *testing.T) {
+	repo, ts, _ := getNewGithubRecordingTestRepo(t, map[string]string{
+		"tag_exclude": `^v2\.|beta`,
+	})
+	defer ts.Close()
+
+	releases, err := repo.GetReleases("")
+	require.NoError(t, err)
+	versions := make([]string, 0, len(releases))
+	for _, r := range releases {
+		versions = append(versions, r.Version)
+	}
+	require.ElementsMatch(t, []string{"1.0.0", "2020.4.19", "1.1.1"}, versions)
+
+	previousTag, err := repo.findPreviousTag("3.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "v1.1.1", previousTag)
+}
+
+func TestGithubInvalidTagExclude(t *testing.T) {
+	repo := &GitHubRepository{}
+	err := repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "tag_exclude": "("})
+	require.ErrorContains(t, err, "failed to parse tag_exclude")
+}
+
+func TestGithubTagVersionParsing(t *testing.T) {
+	testCases := []struct {
+		config map[string]string
+		tag    string
+		valid  bool
+	}{
+		{map[string]string{}, "v1.2", true},
+		{map[string]string{"tag_version_parsing": "coerce"}, "v1.2", true},
+		{map[string]string{"tag_version_parsing": "strict"}, "v1.2", false},
+		{map[string]string{"tag_version_parsing": "strict"}, "v1.2.3", true},
+		{map[string]string{"github_update_major_tags": "true"}, "v1", false},
+		{map[string]string{"github_update_major_tags": "true", "tag_version_parsing": "strict"}, "v1.2.3-rc.1", true},
+	}
+	for _, tc := range testCases {
+		tc.config["slug"] = "owner/test-repo"
+		tc.config["token"] = "token"
+		repo := &GitHubRepository{}
+		require.NoError(t, repo.Init(tc.config))
+		_, err := repo.parseTagVersion(tc.tag)
+		require.Equal(t, tc.valid, err == nil, "%v %s", tc.config, tc.tag)
+	}
+
+	repo := &GitHubRepository{}
+	err := repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "tag_version_parsing": "coerce", "github_update_minor_tags": "true"})
+	require.ErrorContains(t, err, "can not be used with floating major or minor tags")
+	err = repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "tag_version_parsing": "loose"})
+	require.ErrorContains(t, err, "invalid value for tag_version_parsing")
+}
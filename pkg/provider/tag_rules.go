@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// tagRuleset is a ruleset including the bypass state of the authenticated user, which is not part of
+// github.Ruleset.
+type tagRuleset struct {
+	github.Ruleset
+	CurrentUserCanBypass string `json:"current_user_can_bypass"`
+}
+
+// refPatternRegexp converts a ruleset ref pattern (fnmatch syntax, ** matches across slashes) to a regexp.
+func refPatternRegexp(pattern string) *regexp.Regexp {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			expr.WriteString("[^/]*")
+		case pattern[i] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	expr.WriteString("$")
+	return regexp.MustCompile(expr.String())
+}
+
+// matchRefPatterns returns the first include pattern that matches ref, if ref is not excluded.
+func matchRefPatterns(conditions *github.RulesetRefConditionParameters, ref string) (string, bool) {
+	if conditions == nil {
+		return "", false
+	}
+	for _, pattern := range conditions.Exclude {
+		if pattern == "~ALL" || refPatternRegexp(pattern).MatchString(ref) {
+			return "", false
+		}
+	}
+	for _, pattern := range conditions.Include {
+		if pattern == "~ALL" || refPatternRegexp(pattern).MatchString(ref) {
+			return pattern, true
+		}
+	}
+	return "", false
+}
+
+func (repo *GitHubRepository) getTagRuleset(id int64) (*tagRuleset, error) {
+	req, err := repo.client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%s/%s/rulesets/%d?includes_parents=true", repo.tagOwner, repo.tagRepo, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	ruleset := &tagRuleset{}
+	if _, err := repo.client.Do(context.Background(), req, ruleset); err != nil {
+		return nil, err
+	}
+	return ruleset, nil
+}
+
+// checkTagRules fails if an active ruleset restricts the creation of the tag and the token can not bypass it,
+// instead of the unspecific error of the ref creation. Legacy tag protection rules have been migrated to rulesets
+// by GitHub. The check is skipped if the rulesets can not be read.
+func (repo *GitHubRepository) checkTagRules(tag string) error {
+	rulesets, resp, err := repo.client.Repositories.GetAllRulesets(context.Background(), repo.tagOwner, repo.tagRepo, true)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		log.Printf("warning: failed to list rulesets, skipping tag protection check: %v", err)
+		return nil
+	}
+	ref := "refs/tags/" + tag
+	for _, summary := range rulesets {
+		if summary.GetTarget() != "tag" || summary.Enforcement != "active" {
+			continue
+		}
+		ruleset, err := repo.getTagRuleset(summary.GetID())
+		if err != nil {
+			log.Printf("warning: failed to get ruleset %s, skipping tag protection check: %v", summary.Name, err)
+			continue
+		}
+		if ruleset.CurrentUserCanBypass == "always" || ruleset.Conditions == nil {
+			continue
+		}
+		pattern, ok := matchRefPatterns(ruleset.Conditions.RefName, ref)
+		if !ok {
+			continue
+		}
+		for _, rule := range ruleset.Rules {
+			if rule.Type == "creation" {
+				return fmt.Errorf("tag %s is protected by the ruleset %q (%s) and the token lacks a bypass, add the token's app or user to the bypass list of the ruleset", tag, ruleset.Name, pattern)
+			}
+		}
+	}
+	return nil
+}
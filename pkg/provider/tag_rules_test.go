@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchRefPatterns(t *testing.T) {
+	conditions := &github.RulesetRefConditionParameters{
+		Include: []string{"refs/tags/v*", "refs/tags/release/**"},
+		Exclude: []string{"refs/tags/v0.*"},
+	}
+	testCases := []struct {
+		ref     string
+		pattern string
+		match   bool
+	}{
+		{"refs/tags/v1.0.0", "refs/tags/v*", true},
+		{"refs/tags/v0.1.0", "", false},
+		{"refs/tags/release/1/0", "refs/tags/release/**", true},
+		{"refs/tags/1.0.0", "", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.ref, func(t *testing.T) {
+			pattern, ok := matchRefPatterns(conditions, tc.ref)
+			require.Equal(t, tc.match, ok)
+			require.Equal(t, tc.pattern, pattern)
+		})
+	}
+	pattern, ok := matchRefPatterns(&github.RulesetRefConditionParameters{Include: []string{"~ALL"}}, "refs/tags/v1.0.0")
+	require.True(t, ok)
+	require.Equal(t, "~ALL", pattern)
+}
+
+func TestGithubTagRules(t *testing.T) {
+	for _, bypass := range []string{"never", "always"} {
+		t.Run(bypass, func(t *testing.T) {
+			repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+			defer ts.Close()
+			rec.handle("GET /repos/owner/test-repo/rulesets", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `[{"id":1,"name":"branches","target":"branch","enforcement":"active"},{"id":2,"name":"releases","target":"tag","enforcement":"active"}]`)
+			})
+			rec.handle("GET /repos/owner/test-repo/rulesets/2", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"id":2,"name":"releases","target":"tag","enforcement":"active","current_user_can_bypass":%q,
+					"conditions":{"ref_name":{"include":["refs/tags/v*"],"exclude":[]}},"rules":[{"type":"creation"}]}`, bypass)
+			})
+
+			err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "main"})
+			if bypass == "always" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, `tag v2.0.0 is protected by the ruleset "releases" (refs/tags/v*)`)
+			require.Nil(t, rec.lastRelease())
+		})
+	}
+}
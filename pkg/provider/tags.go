@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-github/v66/github"
+)
+
+// tagPayload returns the raw git tag object that is signed for signed tags.
+func tagPayload(tag, sha, message string, tagger *github.CommitAuthor) string {
+	return fmt.Sprintf("object %s\ntype commit\ntag %s\ntagger %s <%s> %d +0000\n\n%s",
+		sha, tag, tagger.GetName(), tagger.GetEmail(), tagger.GetDate().Unix(), message)
+}
+
+// createTagObject creates an annotated tag object for sha and returns the SHA of the tag object.
+func (repo *GitHubRepository) createTagObject(tag, sha string) (string, error) {
+	message := tag + "\n"
+	tagObject := &github.Tag{
+		Tag:     &tag,
+		Message: &message,
+		Object:  &github.GitObject{SHA: &sha, Type: github.String("commit")},
+	}
+	taggerName, taggerEmail := repo.taggerName, repo.taggerEmail
+	if repo.signTags && (taggerName == "" || taggerEmail == "") {
+		// the tagger has to be known to create the signature, fall back to the identity of the signing key
+		if identity := repo.gpgEntity.PrimaryIdentity(); identity != nil {
+			taggerName, taggerEmail = identity.UserId.Name, identity.UserId.Email
+		}
+	}
+	if taggerName != "" && taggerEmail != "" {
+		tagObject.Tagger = &github.CommitAuthor{
+			Name:  &taggerName,
+			Email: &taggerEmail,
+			Date:  &github.Timestamp{Time: time.Now().UTC().Truncate(time.Second)},
+		}
+	}
+	if repo.signTags {
+		if tagObject.Tagger == nil {
+			return "", errors.New("tagger name and email are required to sign tags")
+		}
+		signature, err := gpgDetachSign(repo.gpgEntity, []byte(tagPayload(tag, sha, message, tagObject.Tagger)))
+		if err != nil {
+			return "", fmt.Errorf("failed to sign tag: %w", err)
+		}
+		tagObject.Message = github.String(message + string(signature))
+	}
+	createdTag, _, err := repo.client.Git.CreateTag(context.Background(), repo.tagOwner, repo.tagRepo, tagObject)
+	if err != nil {
+		return "", err
+	}
+	return createdTag.GetSHA(), nil
+}
+
+// createTag creates the tag ref for sha, for annotated tags a tag object is created first.
+func (repo *GitHubRepository) createTag(tag, sha string) error {
+	objectSHA := sha
+	if repo.annotatedTags || repo.signTags {
+		var err error
+		objectSHA, err = repo.createTagObject(tag, sha)
+		if err != nil {
+			return err
+		}
+	}
+	ref := "refs/tags/" + tag
+	tagOpts := &github.Reference{
+		Ref:    &ref,
+		Object: &github.GitObject{SHA: &objectSHA},
+	}
+	_, _, err := repo.client.Git.CreateRef(context.Background(), repo.tagOwner, repo.tagRepo, tagOpts)
+	if isAlreadyExistsError(err) {
+		// the tag was created by another job or manually, this is fine as long as it points at the same commit
+		existingSHA, err := repo.resolveTag(tag)
+		if err != nil {
+			return fmt.Errorf("failed to resolve existing tag %s: %w", tag, err)
+		}
+		if existingSHA != sha {
+			return withSentinel(ErrTagExists, fmt.Errorf("tag %s already exists and points at %s instead of %s", tag, existingSHA, sha))
+		}
+		return nil
+	}
+	return err
+}
+
+// resolveTag returns the SHA of the commit the given tag points at.
+func (repo *GitHubRepository) resolveTag(tag string) (string, error) {
+	ref, _, err := repo.client.Git.GetRef(context.Background(), repo.tagOwner, repo.tagRepo, "tags/"+tag)
+	if err != nil {
+		return "", err
+	}
+	objType, objSHA := ref.GetObject().GetType(), ref.GetObject().GetSHA()
+	if objType == "tag" {
+		resTag, _, err := repo.client.Git.GetTag(context.Background(), repo.tagOwner, repo.tagRepo, objSHA)
+		if err != nil {
+			return "", err
+		}
+		objType, objSHA = resTag.GetObject().GetType(), resTag.GetObject().GetSHA()
+	}
+	if objType != "commit" {
+		return "", fmt.Errorf("tag %s does not point at a commit", tag)
+	}
+	return objSHA, nil
+}
+
+// updateAliasTags force-updates the floating major (v1) and minor (v1.2) tags to point at sha.
+func (repo *GitHubRepository) updateAliasTags(version, sha string) error {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return err
+	}
+	if v.Prerelease() != "" {
+		return nil
+	}
+	aliases := make([]string, 0, 2)
+	if repo.updateMajorTags {
+		aliases = append(aliases, repo.formatTag(fmt.Sprintf("%d", v.Major())))
+	}
+	if repo.updateMinorTags {
+		aliases = append(aliases, repo.formatTag(fmt.Sprintf("%d.%d", v.Major(), v.Minor())))
+	}
+	for _, alias := range aliases {
+		ref := &github.Reference{
+			Ref:    github.String("refs/tags/" + alias),
+			Object: &github.GitObject{SHA: &sha},
+		}
+		_, resp, err := repo.client.Git.GetRef(context.Background(), repo.tagOwner, repo.tagRepo, "tags/"+alias)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			_, _, err = repo.client.Git.CreateRef(context.Background(), repo.tagOwner, repo.tagRepo, ref)
+		} else if err == nil {
+			_, _, err = repo.client.Git.UpdateRef(context.Background(), repo.tagOwner, repo.tagRepo, ref, true)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update alias tag %s: %w", alias, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubCreateAnnotatedTag(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_annotated_tags": "true",
+		"github_tagger_name":    "semantic-release",
+		"github_tagger_email":   "release@example.com",
+	})
+	defer ts.Close()
+
+	var createdTag struct {
+		Tag     string               `json:"tag"`
+		Message string               `json:"message"`
+		Object  string               `json:"object"`
+		Type    string               `json:"type"`
+		Tagger  *github.CommitAuthor `json:"tagger"`
+	}
+	rec.handle("POST /repos/owner/test-repo/git/tags", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&createdTag))
+		fmt.Fprint(w, `{"sha": "7a9"}`)
+	})
+	var createdRef map[string]string
+	rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&createdRef))
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, "v2.0.0", createdTag.Tag)
+	require.Equal(t, testSHA, createdTag.Object)
+	require.Equal(t, "commit", createdTag.Type)
+	require.Equal(t, "semantic-release", createdTag.Tagger.GetName())
+	require.Equal(t, "refs/tags/v2.0.0", createdRef["ref"])
+	require.Equal(t, "7a9", createdRef["sha"])
+}
+
+func TestGithubCreateSignedTag(t *testing.T) {
+	key, publicEntity := createTestGPGKey(t, "")
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_sign_tags": "true",
+		"gpg_private_key":  key,
+	})
+	defer ts.Close()
+
+	var createdTag struct {
+		Tag     string               `json:"tag"`
+		Message string               `json:"message"`
+		Object  string               `json:"object"`
+		Tagger  *github.CommitAuthor `json:"tagger"`
+	}
+	rec.handle("POST /repos/owner/test-repo/git/tags", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&createdTag))
+		fmt.Fprint(w, `{"sha": "7a9"}`)
+	})
+	rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, "semantic-release", createdTag.Tagger.GetName())
+	require.Equal(t, "semrel@example.com", createdTag.Tagger.GetEmail())
+
+	message, signature, found := strings.Cut(createdTag.Message, "-----BEGIN PGP SIGNATURE-----")
+	require.True(t, found)
+	require.Equal(t, "v2.0.0\n", message)
+	payload := tagPayload(createdTag.Tag, createdTag.Object, message, createdTag.Tagger)
+	_, err = openpgp.CheckArmoredDetachedSignature(openpgp.EntityList{publicEntity}, strings.NewReader(payload),
+		strings.NewReader("-----BEGIN PGP SIGNATURE-----"+signature), nil)
+	require.NoError(t, err)
+}
+
+func TestGithubSignTagsRequiresKey(t *testing.T) {
+	repo := &GitHubRepository{}
+	err := repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_sign_tags": "true"})
+	require.ErrorContains(t, err, "requires a gpg private key")
+}
+
+func TestGithubCreateExistingTag(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ref    string
+		errMsg string
+	}{
+		{"same commit", `{"ref": "refs/tags/v2.0.0", "object": {"type": "commit", "sha": "deadbeef"}}`, ""},
+		{"annotated tag", `{"ref": "refs/tags/v2.0.0", "object": {"type": "tag", "sha": "12345678"}}`, ""},
+		{"other commit", `{"ref": "refs/tags/v2.0.0", "object": {"type": "commit", "sha": "beefdead"}}`, "points at beefdead"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{})
+			defer ts.Close()
+			rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				fmt.Fprint(w, `{"message": "Reference already exists"}`)
+			})
+			rec.handle("GET /repos/owner/test-repo/git/ref/tags/v2.0.0", func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprint(w, tc.ref)
+			})
+
+			err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				require.ErrorIs(t, err, ErrTagExists)
+				require.Nil(t, rec.lastRelease())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "v2.0.0", rec.lastRelease().GetTagName())
+		})
+	}
+}
+
+func TestGithubUpdateAliasTags(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_update_major_tags": "true",
+		"github_update_minor_tags": "true",
+	})
+	defer ts.Close()
+
+	rec.handle("GET /repos/owner/test-repo/git/ref/tags/v2", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"ref": "refs/tags/v2", "object": {"sha": "1111", "type": "commit"}}`)
+	})
+	rec.handle("GET /repos/owner/test-repo/git/ref/tags/v2.0", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+	var updatedRef, createdRef map[string]any
+	rec.handle("PATCH /repos/owner/test-repo/git/refs/tags/v2", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&updatedRef))
+		fmt.Fprint(w, "{}")
+	})
+	rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		var data map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&data))
+		if data["ref"] == "refs/tags/v2.0" {
+			createdRef = data
+		}
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.NoError(t, err)
+	require.Equal(t, testSHA, updatedRef["sha"])
+	require.Equal(t, true, updatedRef["force"])
+	require.Equal(t, testSHA, createdRef["sha"])
+
+	// alias tags are not parsed as releases
+	rec.handle("GET /repos/owner/test-repo/git/matching-refs/tags", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]*github.Reference{
+			createGithubRef("refs/tags/v2"),
+			createGithubRef("refs/tags/v2.0"),
+			createGithubRef("refs/tags/v2.0.0"),
+		})
+	})
+	releases, err := repo.GetReleases("")
+	require.NoError(t, err)
+	require.Len(t, releases, 1)
+	require.Equal(t, "2.0.0", releases[0].Version)
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/go-github/v66/github"
+)
+
+const trackingIssueLabel = "release-tracking"
+
+const pinIssueMutation = `mutation($issueId: ID!) {
+  pinIssue(input: {issueId: $issueId}) { issue { number } }
+}`
+
+const unpinIssueMutation = `mutation($issueId: ID!) {
+  unpinIssue(input: {issueId: $issueId}) { issue { number } }
+}`
+
+func trackingIssueTitle(tag string) string {
+	return "Release " + tag
+}
+
+// listTrackingIssues returns the open and closed issues labeled as release tracking issue.
+func (repo *GitHubRepository) listTrackingIssues() ([]*github.Issue, error) {
+	allIssues := make([]*github.Issue, 0)
+	opts := &github.IssueListByRepoOptions{State: "all", Labels: []string{trackingIssueLabel}, ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		issues, resp, err := repo.client.Issues.ListByRepo(context.Background(), repo.owner, repo.repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			if issue.IsPullRequest() {
+				continue
+			}
+			allIssues = append(allIssues, issue)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return allIssues, nil
+}
+
+// setIssuePinned pins or unpins the issue, failures are only logged because at most three issues can be pinned.
+func (repo *GitHubRepository) setIssuePinned(issue *github.Issue, pinned bool) {
+	mutation := pinIssueMutation
+	if !pinned {
+		mutation = unpinIssueMutation
+	}
+	if err := repo.graphQL(mutation, map[string]interface{}{"issueId": issue.GetNodeID()}, &struct{}{}); err != nil {
+		log.Printf("warning: failed to update the pin of issue #%d: %v", issue.GetNumber(), err)
+	}
+}
+
+// updateTrackingIssue creates or updates the pinned tracking issue of the release and closes the tracking issues
+// of the previous releases.
+func (repo *GitHubRepository) updateTrackingIssue(tag, changelog string) error {
+	issues, err := repo.listTrackingIssues()
+	if err != nil {
+		return fmt.Errorf("failed to list release tracking issues: %w", err)
+	}
+	title := trackingIssueTitle(tag)
+	body := appendSection(changelog, repo.trackingIssueChecklist)
+	var trackingIssue *github.Issue
+	for _, issue := range issues {
+		if issue.GetTitle() == title {
+			trackingIssue = issue
+			break
+		}
+	}
+	if trackingIssue == nil {
+		trackingIssue, _, err = repo.client.Issues.Create(context.Background(), repo.owner, repo.repo, &github.IssueRequest{
+			Title:  github.String(title),
+			Body:   github.String(body),
+			Labels: &[]string{trackingIssueLabel},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create release tracking issue: %w", err)
+		}
+	} else {
+		_, _, err = repo.client.Issues.Edit(context.Background(), repo.owner, repo.repo, trackingIssue.GetNumber(), &github.IssueRequest{
+			Body:  github.String(body),
+			State: github.String("open"),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update release tracking issue #%d: %w", trackingIssue.GetNumber(), err)
+		}
+	}
+	repo.setIssuePinned(trackingIssue, true)
+
+	for _, issue := range issues {
+		if issue.GetNumber() == trackingIssue.GetNumber() || issue.GetState() == "closed" {
+			continue
+		}
+		_, _, err = repo.client.Issues.Edit(context.Background(), repo.owner, repo.repo, issue.GetNumber(), &github.IssueRequest{
+			State:       github.String("closed"),
+			StateReason: github.String("completed"),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to close release tracking issue #%d: %w", issue.GetNumber(), err)
+		}
+		repo.setIssuePinned(issue, false)
+	}
+	return nil
+}
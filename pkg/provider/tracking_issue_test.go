@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubTrackingIssue(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_tracking_issue":           "true",
+		"github_tracking_issue_checklist": "- [ ] announce",
+	})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/issues", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "release-tracking", r.URL.Query().Get("labels"))
+		_ = json.NewEncoder(w).Encode([]*github.Issue{
+			{Number: github.Int(1), Title: github.String("Release v1.0.0"), State: github.String("closed"), NodeID: github.String("I_1")},
+			{Number: github.Int(2), Title: github.String("Release v1.1.1"), State: github.String("open"), NodeID: github.String("I_2")},
+		})
+	})
+	var created *github.IssueRequest
+	rec.handle("POST /repos/owner/test-repo/issues", func(w http.ResponseWriter, r *http.Request) {
+		created = &github.IssueRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(created))
+		fmt.Fprint(w, `{"number":3,"node_id":"I_3"}`)
+	})
+	var closed *github.IssueRequest
+	rec.handle("PATCH /repos/owner/test-repo/issues/2", func(w http.ResponseWriter, r *http.Request) {
+		closed = &github.IssueRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(closed))
+		fmt.Fprint(w, "{}")
+	})
+	mutations := make([]string, 0)
+	rec.handle("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+		var request graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		mutation := "pin"
+		if strings.Contains(request.Query, "unpinIssue") {
+			mutation = "unpin"
+		}
+		mutations = append(mutations, fmt.Sprintf("%s %s", mutation, request.Variables["issueId"]))
+		fmt.Fprint(w, `{"data":{}}`)
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog"})
+	require.NoError(t, err)
+	require.Equal(t, "Release v2.0.0", created.GetTitle())
+	require.Equal(t, "changelog\n\n- [ ] announce", created.GetBody())
+	require.Equal(t, []string{"release-tracking"}, created.GetLabels())
+	require.Equal(t, "closed", closed.GetState())
+	require.Equal(t, []string{"pin I_3", "unpin I_2"}, mutations)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v66/github"
+)
+
+const (
+	branchMovedFail = "fail"
+	branchMovedWarn = "warn"
+)
+
+// verifyReleaseSHA makes sure that sha exists in the repository and, if enabled, is reachable from branch.
+func (repo *GitHubRepository) verifyReleaseSHA(sha, branch string) error {
+	_, resp, err := repo.client.Repositories.GetCommitSHA1(context.Background(), repo.owner, repo.repo, sha, "")
+	if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusUnprocessableEntity) {
+		return fmt.Errorf("SHA %s does not exist in %s/%s", sha, repo.owner, repo.repo)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to verify SHA %s: %w", sha, err)
+	}
+	if !repo.verifyBranch || branch == "" {
+		return nil
+	}
+	// sha is on the branch if the branch is identical to or ahead of sha
+	comparison, _, err := repo.client.Repositories.CompareCommits(context.Background(), repo.owner, repo.repo, sha, branch, &github.ListOptions{PerPage: 1})
+	if err != nil {
+		return fmt.Errorf("failed to verify that SHA %s is on branch %s: %w", sha, branch, err)
+	}
+	switch comparison.GetStatus() {
+	case "identical", "ahead":
+		return nil
+	default:
+		return fmt.Errorf("SHA %s is not on branch %s", sha, branch)
+	}
+}
+
+// checkBranchHead detects whether branch has advanced past sha since the commits were analyzed.
+func (repo *GitHubRepository) checkBranchHead(sha, branch string) error {
+	if repo.branchMoved == "" || branch == "" {
+		return nil
+	}
+	ref, _, err := repo.client.Git.GetRef(context.Background(), repo.owner, repo.repo, "heads/"+branch)
+	if err != nil {
+		return fmt.Errorf("failed to get the head of branch %s: %w", branch, err)
+	}
+	head := ref.GetObject().GetSHA()
+	if head == sha {
+		return nil
+	}
+	if repo.branchMoved == branchMovedWarn {
+		log.Printf("warning: branch %s has advanced to %s, releasing %s", branch, head, sha)
+		return nil
+	}
+	return fmt.Errorf("branch %s has advanced to %s since %s was analyzed", branch, head, sha)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubVerifyReleaseSHA(t *testing.T) {
+	testCases := []struct {
+		name   string
+		sha    string
+		status string
+		errMsg string
+	}{
+		{"on branch", testSHA, "ahead", ""},
+		{"branch head", testSHA, "identical", ""},
+		{"diverged", testSHA, "diverged", "SHA deadbeef is not on branch main"},
+		{"missing", "beefdead", "", "SHA beefdead does not exist"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+				"github_verify_branch": "true",
+			})
+			defer ts.Close()
+			rec.handle("GET /repos/owner/test-repo/commits/beefdead", func(w http.ResponseWriter, _ *http.Request) {
+				http.Error(w, "not found", http.StatusUnprocessableEntity)
+			})
+			rec.handle("GET /repos/owner/test-repo/compare/deadbeef...main", func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprintf(w, `{"status": %q}`, tc.status)
+			})
+
+			err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: tc.sha, Branch: "main"})
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				require.Nil(t, rec.lastRelease())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGithubBranchMoved(t *testing.T) {
+	testCases := []struct {
+		mode   string
+		head   string
+		errMsg string
+	}{
+		{"fail", testSHA, ""},
+		{"fail", "beefdead", "branch main has advanced to beefdead"},
+		{"warn", "beefdead", ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.mode+"/"+tc.head, func(t *testing.T) {
+			repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+				"github_branch_moved": tc.mode,
+			})
+			defer ts.Close()
+			rec.handle("GET /repos/owner/test-repo/git/ref/heads/main", func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprintf(w, `{"ref": "refs/heads/main", "object": {"type": "commit", "sha": %q}}`, tc.head)
+			})
+
+			err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "main"})
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGithubInvalidBranchMoved(t *testing.T) {
+	repo := &GitHubRepository{}
+	err := repo.Init(map[string]string{"slug": "owner/test-repo", "token": "token", "github_branch_moved": "ignore"})
+	require.ErrorContains(t, err, "invalid value for github_branch_moved")
+}
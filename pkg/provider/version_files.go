@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/google/go-github/v66/github"
+	"gopkg.in/yaml.v3"
+)
+
+// versionFile describes how the version is updated in a file. Without json and regex the file only contains
+// the version.
+type versionFile struct {
+	Path string `yaml:"path"`
+	// JSON is the dot separated path of a string value, e.g. version or tool.version
+	JSON    string `yaml:"json"`
+	Regex   string `yaml:"regex"`
+	Replace string `yaml:"replace"`
+
+	regex   *regexp.Regexp
+	replace *template.Template
+}
+
+// versionFileData is passed to the replace templates of the version files.
+type versionFileData struct {
+	Version string
+	Tag     string
+}
+
+func parseVersionFiles(value string) ([]*versionFile, error) {
+	raw, err := readInlineOrFile(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read github_version_files: %w", err)
+	}
+	if raw == "" {
+		return nil, nil
+	}
+	files := make([]*versionFile, 0)
+	if err := yaml.Unmarshal([]byte(raw), &files); err != nil {
+		return nil, fmt.Errorf("failed to parse github_version_files: %w", err)
+	}
+	for _, file := range files {
+		if file.Path == "" {
+			return nil, errors.New("github_version_files: path is missing")
+		}
+		if file.JSON != "" && file.Regex != "" {
+			return nil, fmt.Errorf("github_version_files: %s can not use json and regex", file.Path)
+		}
+		if file.Regex == "" {
+			continue
+		}
+		file.regex, err = regexp.Compile(file.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("github_version_files: invalid regex of %s: %w", file.Path, err)
+		}
+		if file.Replace == "" {
+			file.Replace = "{{.Version}}"
+		}
+		file.replace, err = template.New(file.Path).Parse(file.Replace)
+		if err != nil {
+			return nil, fmt.Errorf("github_version_files: invalid replace of %s: %w", file.Path, err)
+		}
+	}
+	return files, nil
+}
+
+// setJSONString replaces the string value at the dot separated path, the formatting of the document is kept.
+func setJSONString(content, path, value string) (string, error) {
+	dec := json.NewDecoder(strings.NewReader(content))
+	keys := strings.Split(path, ".")
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return "", errors.New("not a JSON object")
+	}
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+		if tok == json.Delim('}') {
+			return "", fmt.Errorf("%s not found", path)
+		}
+		if tok != keys[0] {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return "", err
+			}
+			continue
+		}
+		keyEnd := int(dec.InputOffset())
+		if len(keys) > 1 {
+			// the remaining path is resolved within the nested object
+			var nested json.RawMessage
+			if err := dec.Decode(&nested); err != nil {
+				return "", err
+			}
+			end := int(dec.InputOffset())
+			start := end - len(nested)
+			updated, err := setJSONString(string(nested), strings.Join(keys[1:], "."), value)
+			if err != nil {
+				return "", err
+			}
+			return content[:start] + updated + content[end:], nil
+		}
+		tok, err = dec.Token()
+		if err != nil {
+			return "", err
+		}
+		if _, ok := tok.(string); !ok {
+			return "", fmt.Errorf("%s is not a string", path)
+		}
+		end := int(dec.InputOffset())
+		start := keyEnd + strings.Index(content[keyEnd:end], `"`)
+		quoted, _ := json.Marshal(value)
+		return content[:start] + string(quoted) + content[end:], nil
+	}
+}
+
+func (file *versionFile) update(content string, data versionFileData) (string, error) {
+	switch {
+	case file.JSON != "":
+		return setJSONString(content, file.JSON, data.Version)
+	case file.regex != nil:
+		if !file.regex.MatchString(content) {
+			return "", fmt.Errorf("%s does not match %s", file.Regex, file.Path)
+		}
+		var replacement bytes.Buffer
+		if err := file.replace.Execute(&replacement, data); err != nil {
+			return "", err
+		}
+		return file.regex.ReplaceAllLiteralString(content, replacement.String()), nil
+	default:
+		return data.Version + "\n", nil
+	}
+}
+
+// commitVersionFiles commits the updated version files on top of sha and fast-forwards the branch to the new
+// commit, so that the tag contains the correct version strings. It returns the SHA that should be tagged.
+func (repo *GitHubRepository) commitVersionFiles(version, tag, branch, sha string) (string, error) {
+	data := versionFileData{Version: version, Tag: tag}
+	entries := make([]*github.TreeEntry, 0, len(repo.versionFiles))
+	for _, file := range repo.versionFiles {
+		content, _, err := repo.readFile(file.Path, sha)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", file.Path, err)
+		}
+		updated, err := file.update(content, data)
+		if err != nil {
+			return "", fmt.Errorf("failed to update the version of %s: %w", file.Path, err)
+		}
+		if updated == content {
+			continue
+		}
+		entries = append(entries, &github.TreeEntry{
+			Path:    github.String(file.Path),
+			Mode:    github.String("100644"),
+			Type:    github.String("blob"),
+			Content: github.String(updated),
+		})
+	}
+	// e.g. the version was already committed by a previous run
+	if len(entries) == 0 {
+		return sha, nil
+	}
+	parent, _, err := repo.client.Git.GetCommit(context.Background(), repo.owner, repo.repo, sha)
+	if err != nil {
+		return "", fmt.Errorf("failed to get commit %s: %w", sha, err)
+	}
+	tree, _, err := repo.client.Git.CreateTree(context.Background(), repo.owner, repo.repo, parent.GetTree().GetSHA(), entries)
+	if err != nil {
+		return "", fmt.Errorf("failed to create tree: %w", err)
+	}
+	commit, _, err := repo.client.Git.CreateCommit(context.Background(), repo.owner, repo.repo, &github.Commit{
+		Message: github.String(fmt.Sprintf("chore(release): %s [skip ci]", tag)),
+		Tree:    &github.Tree{SHA: tree.SHA},
+		Parents: []*github.Commit{{SHA: github.String(sha)}},
+	}, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create version commit: %w", err)
+	}
+	// not forced, the update fails if the branch has moved in the meantime
+	_, _, err = repo.client.Git.UpdateRef(context.Background(), repo.owner, repo.repo, &github.Reference{
+		Ref:    github.String("refs/heads/" + branch),
+		Object: &github.GitObject{SHA: commit.SHA},
+	}, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to push version commit to %s: %w", branch, err)
+	}
+	return commit.GetSHA(), nil
+}
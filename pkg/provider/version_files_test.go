@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetJSONString(t *testing.T) {
+	content := "{\n  \"name\": \"app\",\n  \"tool\": {\"version\": \"0.1.0\"},\n  \"version\": \"1.0.0\",\n  \"scripts\": {}\n}\n"
+	updated, err := setJSONString(content, "version", "2.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "{\n  \"name\": \"app\",\n  \"tool\": {\"version\": \"0.1.0\"},\n  \"version\": \"2.0.0\",\n  \"scripts\": {}\n}\n", updated)
+	updated, err = setJSONString(content, "tool.version", "2.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "{\n  \"name\": \"app\",\n  \"tool\": {\"version\": \"2.0.0\"},\n  \"version\": \"1.0.0\",\n  \"scripts\": {}\n}\n", updated)
+
+	_, err = setJSONString(content, "missing", "2.0.0")
+	require.EqualError(t, err, "missing not found")
+	_, err = setJSONString(content, "scripts", "2.0.0")
+	require.EqualError(t, err, "scripts is not a string")
+	_, err = setJSONString("[]", "version", "2.0.0")
+	require.EqualError(t, err, "not a JSON object")
+}
+
+func TestParseVersionFiles(t *testing.T) {
+	files, err := parseVersionFiles(`
+- path: VERSION
+- path: chart/Chart.yaml
+  regex: '(?m)^appVersion: .*$'
+  replace: 'appVersion: {{.Tag}}'
+`)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	updated, err := files[0].update("1.0.0\n", versionFileData{Version: "2.0.0", Tag: "v2.0.0"})
+	require.NoError(t, err)
+	require.Equal(t, "2.0.0\n", updated)
+	updated, err = files[1].update("name: app\nappVersion: v1.0.0\n", versionFileData{Version: "2.0.0", Tag: "v2.0.0"})
+	require.NoError(t, err)
+	require.Equal(t, "name: app\nappVersion: v2.0.0\n", updated)
+	_, err = files[1].update("name: app\n", versionFileData{Version: "2.0.0"})
+	require.ErrorContains(t, err, "does not match chart/Chart.yaml")
+
+	_, err = parseVersionFiles("- json: version")
+	require.EqualError(t, err, "github_version_files: path is missing")
+	_, err = parseVersionFiles("- {path: package.json, json: version, regex: x}")
+	require.EqualError(t, err, "github_version_files: package.json can not use json and regex")
+}
+
+func TestGithubVersionFiles(t *testing.T) {
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_version_files": "[{path: package.json, json: version}, {path: VERSION}]",
+	})
+	defer ts.Close()
+	files := map[string]string{"package.json": `{"version": "1.1.1"}`, "VERSION": "2.0.0\n"}
+	for path, content := range files {
+		content := content
+		rec.handle("GET /repos/owner/test-repo/contents/"+path, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, testSHA, r.URL.Query().Get("ref"))
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"type":     "file",
+				"encoding": "base64",
+				"content":  base64.StdEncoding.EncodeToString([]byte(content)),
+			})
+		})
+	}
+	rec.handle("GET /repos/owner/test-repo/git/commits/"+testSHA, func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"sha":"deadbeef","tree":{"sha":"basetree"}}`)
+	})
+	var tree map[string]interface{}
+	rec.handle("POST /repos/owner/test-repo/git/trees", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&tree))
+		fmt.Fprint(w, `{"sha":"newtree"}`)
+	})
+	var commit map[string]interface{}
+	rec.handle("POST /repos/owner/test-repo/git/commits", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&commit))
+		fmt.Fprint(w, `{"sha":"bump"}`)
+	})
+	var branchUpdate map[string]interface{}
+	rec.handle("PATCH /repos/owner/test-repo/git/refs/heads/master", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&branchUpdate))
+		fmt.Fprint(w, "{}")
+	})
+	var tagRef map[string]string
+	rec.handle("POST /repos/owner/test-repo/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&tagRef))
+		fmt.Fprint(w, "{}")
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Branch: "master"})
+	require.NoError(t, err)
+	require.Equal(t, "basetree", tree["base_tree"])
+	require.Equal(t, []interface{}{map[string]interface{}{
+		"path": "package.json", "mode": "100644", "type": "blob", "content": `{"version": "2.0.0"}`,
+	}}, tree["tree"])
+	require.Equal(t, "chore(release): v2.0.0 [skip ci]", commit["message"])
+	require.Equal(t, []interface{}{testSHA}, commit["parents"])
+	require.Equal(t, map[string]interface{}{"sha": "bump", "force": false}, branchUpdate)
+	require.Equal(t, map[string]string{"ref": "refs/tags/v2.0.0", "sha": "bump"}, tagRef)
+}
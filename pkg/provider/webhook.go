@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/go-github/v66/github"
+)
+
+var webhookClient = &http.Client{Timeout: 30 * time.Second}
+
+type webhookAsset struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type webhookPayload struct {
+	Owner      string         `json:"owner"`
+	Repo       string         `json:"repo"`
+	Version    string         `json:"version"`
+	Tag        string         `json:"tag"`
+	Prerelease bool           `json:"prerelease"`
+	Changelog  string         `json:"changelog"`
+	ReleaseURL string         `json:"release_url"`
+	Assets     []webhookAsset `json:"assets"`
+}
+
+// webhookSignature returns the hex encoded HMAC-SHA256 of the payload, using the same header format as GitHub.
+func webhookSignature(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (repo *GitHubRepository) listReleaseAssets(releaseID int64) ([]webhookAsset, error) {
+	assets := make([]webhookAsset, 0)
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		releaseAssets, resp, err := repo.client.Repositories.ListReleaseAssets(context.Background(), repo.releaseOwner, repo.releaseRepo, releaseID, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, asset := range releaseAssets {
+			assets = append(assets, webhookAsset{Name: asset.GetName(), URL: asset.GetBrowserDownloadURL()})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return assets, nil
+}
+
+// sendReleaseWebhook posts the release to the configured webhook URL, the payload is signed if a secret is set.
+func (repo *GitHubRepository) sendReleaseWebhook(version, tag, changelog string, release *github.RepositoryRelease) error {
+	assets, err := repo.listReleaseAssets(release.GetID())
+	if err != nil {
+		return fmt.Errorf("failed to list release assets: %w", err)
+	}
+	payload, err := json.Marshal(&webhookPayload{
+		Owner:      repo.owner,
+		Repo:       repo.repo,
+		Version:    version,
+		Tag:        tag,
+		Prerelease: release.GetPrerelease(),
+		Changelog:  changelog,
+		ReleaseURL: release.GetHTMLURL(),
+		Assets:     assets,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, repo.webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "provider-github/"+PVERSION)
+	if repo.webhookSecret != "" {
+		req.Header.Set("X-Hub-Signature-256", webhookSignature(repo.webhookSecret, payload))
+	}
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send release webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("release webhook returned %s", resp.Status)
+	}
+	return nil
+}
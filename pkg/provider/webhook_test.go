@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-semantic-release/semantic-release/v2/pkg/provider"
+	"github.com/google/go-github/v66/github"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubReleaseWebhook(t *testing.T) {
+	var payload webhookPayload
+	var signature string
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &payload))
+		signature = r.Header.Get("X-Hub-Signature-256")
+		require.Equal(t, webhookSignature("secret", data), signature)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer hook.Close()
+
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{
+		"github_release_webhook_url":    hook.URL,
+		"github_release_webhook_secret": "secret",
+	})
+	defer ts.Close()
+	releaseURL := "https://github.com/owner/test-repo/releases/tag/v2.0.0"
+	rec.handle("POST /repos/owner/test-repo/releases", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(github.RepositoryRelease{ID: github.Int64(5), HTMLURL: &releaseURL})
+	})
+	rec.handle("GET /repos/owner/test-repo/releases/5/assets", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[{"name":"app.tar.gz","browser_download_url":"https://github.com/owner/test-repo/releases/download/v2.0.0/app.tar.gz"}]`)
+	})
+
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA, Changelog: "changelog"})
+	require.NoError(t, err)
+	require.Equal(t, webhookPayload{
+		Owner:      "owner",
+		Repo:       "test-repo",
+		Version:    "2.0.0",
+		Tag:        "v2.0.0",
+		Changelog:  "changelog",
+		ReleaseURL: releaseURL,
+		Assets:     []webhookAsset{{Name: "app.tar.gz", URL: "https://github.com/owner/test-repo/releases/download/v2.0.0/app.tar.gz"}},
+	}, payload)
+	require.NotEmpty(t, signature)
+}
+
+func TestGithubReleaseWebhookError(t *testing.T) {
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	defer hook.Close()
+
+	repo, ts, rec := getNewGithubRecordingTestRepo(t, map[string]string{"github_release_webhook_url": hook.URL})
+	defer ts.Close()
+	rec.handle("GET /repos/owner/test-repo/releases/0/assets", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "[]")
+	})
+	err := repo.CreateRelease(&provider.CreateReleaseConfig{NewVersion: "2.0.0", SHA: testSHA})
+	require.EqualError(t, err, "release webhook returned 500 Internal Server Error")
+}